@@ -0,0 +1,41 @@
+// migrate-version-storage 一次性迁移命令：将 article_versions 中全量存储的历史版本
+// 转换为快照+增量的压缩存储。可重复执行，已转换的版本会被跳过。
+//
+// 用法:
+//
+//	go run ./cmd/migrate-version-storage -dry-run
+//	go run ./cmd/migrate-version-storage -batch 200
+package main
+
+import (
+	"flag"
+	"log"
+
+	"terminal-terrace/sse-wiki/config"
+	"terminal-terrace/sse-wiki/internal/article"
+	"terminal-terrace/sse-wiki/internal/database"
+)
+
+func main() {
+	configPath := flag.String("config", "config.yaml", "配置文件路径")
+	batchSize := flag.Int("batch", 100, "每批转换的版本数量")
+	dryRun := flag.Bool("dry-run", false, "只统计待转换的版本数量，不修改数据")
+	flag.Parse()
+
+	// 1. 加载配置并初始化数据库（会同步新增的存储字段）
+	config.MustLoad(*configPath)
+	database.InitDatabase()
+
+	// 2. 转换历史版本
+	versionRepo := article.NewVersionRepository(database.GetDB())
+	count, err := versionRepo.MigrateToDeltaStorage(*batchSize, *dryRun)
+	if err != nil {
+		log.Fatalf("[migrate-version-storage] 迁移失败（已转换 %d 个版本）: %v", count, err)
+	}
+
+	if *dryRun {
+		log.Printf("[migrate-version-storage] 待转换版本数量: %d", count)
+		return
+	}
+	log.Printf("[migrate-version-storage] 迁移完成，共转换 %d 个版本", count)
+}
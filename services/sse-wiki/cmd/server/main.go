@@ -3,8 +3,10 @@ package main
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"terminal-terrace/sse-wiki/config"
+	"terminal-terrace/sse-wiki/internal/article"
 	"terminal-terrace/sse-wiki/internal/database"
 	grpcserver "terminal-terrace/sse-wiki/internal/grpc"
 	"terminal-terrace/sse-wiki/internal/model"
@@ -30,7 +32,24 @@ func main() {
 		log.Fatalf("[sse-wiki] 数据库迁移失败: %v", err)
 	}
 
-	// 5. 启动 gRPC server (blocking)
+	// 5. 创建后台任务共用的文章服务
+	articleSvc := newArticleService()
+
+	// 6. 启动回收站清理任务
+	stopTrashPurge := startTrashPurgeJob(articleSvc)
+	defer stopTrashPurge()
+
+	// 7. 启动阅读量写入任务
+	stopViewFlush := startViewFlushJob(articleSvc)
+	defer stopViewFlush()
+
+	// 8. 后台补建缺失或过期的搜索索引
+	go reindexSearch(articleSvc)
+
+	// 9. 订阅源链接使用的站点地址
+	article.SetFeedSiteURL(config.Conf.Feed.SiteURL)
+
+	// 10. 启动 gRPC server (blocking)
 	grpcPort := config.Conf.GRPC.Port
 	if grpcPort == 0 {
 		grpcPort = 50052 // 默认端口
@@ -40,8 +59,9 @@ func main() {
 	articleService := grpcserver.NewArticleServiceImpl()
 	reviewService := grpcserver.NewReviewServiceImpl()
 	discussionService := grpcserver.NewDiscussionServiceImpl()
+	tagService := grpcserver.NewTagServiceImpl()
 
-	server, err := grpcserver.NewServer(grpcPort, moduleService, articleService, reviewService, discussionService)
+	server, err := grpcserver.NewServer(grpcPort, moduleService, articleService, reviewService, discussionService, tagService)
 	if err != nil {
 		log.Fatalf("[sse-wiki] gRPC server 启动失败: %v", err)
 	}
@@ -88,3 +108,50 @@ func ensureDatabaseExists() error {
 	}
 	return sqlDB.Close()
 }
+
+// newArticleService 创建后台任务使用的文章服务
+func newArticleService() *article.ArticleService {
+	db := database.GetDB()
+	return article.NewArticleService(
+		article.NewArticleRepository(db),
+		article.NewVersionRepository(db),
+		article.NewSubmissionRepository(db),
+		article.NewTagRepository(db),
+		article.NewMergeService(),
+	)
+}
+
+// startTrashPurgeJob 按配置启动回收站清理任务
+func startTrashPurgeJob(articleService *article.ArticleService) func() {
+	trashConf := config.Conf.Trash
+	article.SetTrashRetention(time.Duration(trashConf.RetentionDays) * 24 * time.Hour)
+
+	interval := time.Duration(trashConf.PurgeInterval) * time.Minute
+	if interval <= 0 {
+		interval = time.Hour // 默认每小时清理一次
+	}
+
+	log.Printf("[sse-wiki] 回收站清理任务已启动, 间隔 %v", interval)
+	return article.StartTrashPurgeJob(articleService, interval)
+}
+
+// startViewFlushJob 按配置启动阅读量写入任务
+func startViewFlushJob(articleService *article.ArticleService) func() {
+	interval := time.Duration(config.Conf.Views.FlushInterval) * time.Second
+	if interval <= 0 {
+		interval = article.DefaultViewFlushInterval
+	}
+
+	log.Printf("[sse-wiki] 阅读量写入任务已启动, 间隔 %v", interval)
+	return article.StartViewFlushJob(articleService, interval)
+}
+
+// reindexSearch 为索引缺失或过期的文章重建搜索索引（历史数据或发布时索引失败的文章）
+func reindexSearch(articleService *article.ArticleService) {
+	reindexed, err := articleService.ReindexStaleArticles()
+	if err != nil {
+		log.Printf("[sse-wiki] 重建搜索索引失败（已处理 %d 篇）: %v", reindexed, err)
+		return
+	}
+	log.Printf("[sse-wiki] 搜索索引已就绪, 重建 %d 篇", reindexed)
+}
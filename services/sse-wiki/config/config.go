@@ -27,6 +27,9 @@ type AppConfig struct {
 	Redis    RedisConfig    `koanf:"redis"`
 	Log      LogConfig      `koanf:"log"`
 	JWT      JWTConfig      `koanf:"jwt"`
+	Trash    TrashConfig    `koanf:"trash"`
+	Views    ViewsConfig    `koanf:"views"`
+	Feed     FeedConfig     `koanf:"feed"`
 }
 
 type GRPCConfig struct {
@@ -67,6 +70,19 @@ type JWTConfig struct {
 	ExpireTime int    `koanf:"expire_time"` // 小时
 }
 
+type TrashConfig struct {
+	RetentionDays int `koanf:"retention_days"` // 回收站保留天数
+	PurgeInterval int `koanf:"purge_interval"` // 清理任务执行间隔（分钟）
+}
+
+type ViewsConfig struct {
+	FlushInterval int `koanf:"flush_interval"` // 阅读量缓冲写入数据库的间隔（秒）
+}
+
+type FeedConfig struct {
+	SiteURL string `koanf:"site_url"` // 订阅源中链接使用的站点地址
+}
+
 // Load 加载配置文件
 func Load(configPath string) error {
 	var err error
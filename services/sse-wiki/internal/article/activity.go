@@ -0,0 +1,98 @@
+package article
+
+import (
+	"errors"
+	"strings"
+)
+
+// activityEventTypes 动态事件类型（按展示顺序）
+var activityEventTypes = []string{
+	"article_created",    // 新建文章
+	"version_published",  // 发布新版本（直接发布或审核通过）
+	"submission_created", // 新建提交
+	"review_decision",    // 审核决定（合并/驳回/要求修改）
+	"comment_created",    // 讨论区评论
+	"article_moved",      // 文章移动到其他模块
+	"module_created",     // 新建模块
+	"module_updated",     // 修改模块信息
+}
+
+// guestActivityEventTypes 游客可见的事件类型
+// 已发布版本的摘要（版本号、提交信息、作者、时间）与订阅源一致对游客公开；提交和审核记录仅登录用户可见
+var guestActivityEventTypes = map[string]bool{
+	"article_created":   true,
+	"version_published": true,
+	"comment_created":   true,
+	"article_moved":     true,
+	"module_created":    true,
+	"module_updated":    true,
+}
+
+// GetActivityFeed 获取全站最近动态（按时间倒序分页）
+// moduleID 非 0 时只返回该模块及其子模块的动态；actorID 非 0 时只返回该用户的操作；eventTypes 为空时返回所有可见类型
+// 回收站中文章的动态不返回；游客（userID 为 0）只能看到文章创建、版本发布、评论、文章移动和模块变更
+func (s *ArticleService) GetActivityFeed(userID uint, moduleID uint, actorID uint, eventTypes []string, page, pageSize int) (map[string]interface{}, error) {
+	requested := make(map[string]bool)
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if eventType == "" {
+			continue
+		}
+		if _, ok := activityEventSources[eventType]; !ok {
+			return nil, errors.New("无效的事件类型")
+		}
+		requested[eventType] = true
+	}
+
+	var visibleTypes []string
+	for _, eventType := range activityEventTypes {
+		if len(requested) > 0 && !requested[eventType] {
+			continue
+		}
+		if userID == 0 && !guestActivityEventTypes[eventType] {
+			continue
+		}
+		visibleTypes = append(visibleTypes, eventType)
+	}
+
+	var moduleIDs []uint
+	if moduleID != 0 {
+		if !s.articleRepo.ModuleExists(moduleID) {
+			return nil, errors.New("模块不存在")
+		}
+		ids, err := s.articleRepo.GetModuleSubtreeIDs(moduleID)
+		if err != nil {
+			return nil, err
+		}
+		moduleIDs = ids
+	}
+
+	offset := (page - 1) * pageSize
+	events, total, err := s.articleRepo.ListActivity(visibleTypes, moduleIDs, actorID, offset, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]interface{}, len(events))
+	for i, event := range events {
+		items[i] = map[string]interface{}{
+			"event_type":    event.EventType,
+			"occurred_at":   event.OccurredAt,
+			"actor_id":      event.ActorID,
+			"actor_name":    event.ActorName,
+			"article_id":    event.ArticleID,
+			"article_title": event.ArticleTitle,
+			"module_id":     event.ModuleID,
+			"module_name":   event.ModuleName,
+			"ref_id":        event.RefID,
+			"detail":        event.Detail,
+		}
+	}
+
+	return map[string]interface{}{
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+		"events":    items,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package article
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// BlameLine 当前版本中一行内容的归属信息（最后一次修改该行的版本）
+type BlameLine struct {
+	LineNumber    int       `json:"line_number"`
+	Content       string    `json:"content"`
+	VersionID     uint      `json:"version_id"`
+	VersionNumber int       `json:"version_number"`
+	AuthorID      uint      `json:"author_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetArticleBlame 获取文章当前版本的逐行归属信息
+// 历史版本不可变，因此结果按 CurrentVersionID 缓存，文章发布新版本后自然失效
+func (s *ArticleService) GetArticleBlame(articleID uint) (map[string]interface{}, error) {
+	art, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, errors.New("文章不存在")
+	}
+	if art.CurrentVersionID == nil {
+		return nil, errors.New("文章没有当前版本，数据可能已损坏")
+	}
+
+	currentVersionID := *art.CurrentVersionID
+	currentVersionNumber, err := s.versionRepo.GetVersionNumber(currentVersionID)
+	if err != nil {
+		return nil, errors.New("当前版本不存在")
+	}
+
+	result := map[string]interface{}{
+		"article_id":     articleID,
+		"version_id":     currentVersionID,
+		"version_number": currentVersionNumber,
+	}
+
+	if cached, ok := s.versionRepo.GetCachedBlame(currentVersionID); ok {
+		var lines []BlameLine
+		if err := json.Unmarshal([]byte(cached), &lines); err == nil {
+			result["lines"] = lines
+			return result, nil
+		}
+	}
+
+	versions, err := s.versionRepo.GetVersions(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := versionChain(versions, currentVersionID)
+	if len(chain) == 0 {
+		return nil, errors.New("当前版本不存在")
+	}
+	lines := s.mergeService.blameChain(chain)
+
+	if data, err := json.Marshal(lines); err == nil {
+		s.versionRepo.CacheBlame(currentVersionID, string(data))
+	} else {
+		// TODO: 生产环境优化 - 移除或使用结构化日志
+		log.Printf("[GetArticleBlame] 序列化blame结果失败, articleID=%d, err=%v", articleID, err)
+	}
+
+	result["lines"] = lines
+	return result, nil
+}
+
+// versionChain 从指定版本沿父版本回溯到初始版本，按从旧到新的顺序返回
+// 父版本优先取 MergedAgainstVersionID（发布时所合并的线上版本），否则取 BaseVersionID
+func versionChain(versions []article.ArticleVersion, headID uint) []article.ArticleVersion {
+	byID := make(map[uint]article.ArticleVersion, len(versions))
+	for _, v := range versions {
+		byID[v.ID] = v
+	}
+
+	var reversed []article.ArticleVersion
+	visited := make(map[uint]bool)
+	for id := &headID; id != nil && !visited[*id]; {
+		v, ok := byID[*id]
+		if !ok {
+			break
+		}
+		visited[*id] = true
+		reversed = append(reversed, v)
+
+		if v.MergedAgainstVersionID != nil && *v.MergedAgainstVersionID != v.ID {
+			id = v.MergedAgainstVersionID
+		} else {
+			id = v.BaseVersionID
+		}
+	}
+
+	chain := make([]article.ArticleVersion, len(reversed))
+	for i, v := range reversed {
+		chain[len(reversed)-1-i] = v
+	}
+	return chain
+}
+
+// blameChain 依次比较版本链上相邻的两个版本：未改动的行沿用父版本的归属，新增或修改的行归属于当前版本
+func (s *MergeService) blameChain(chain []article.ArticleVersion) []BlameLine {
+	var prevLines []string
+	var prevBlame []BlameLine
+
+	for _, v := range chain {
+		// 去掉行尾换行符后再比较，避免在末行之后追加内容时把原末行误判为修改
+		lines := splitLines(v.Content)
+		blame := make([]BlameLine, len(lines))
+		for i := range lines {
+			lines[i] = strings.TrimSuffix(lines[i], "\n")
+			blame[i] = BlameLine{
+				VersionID:     v.ID,
+				VersionNumber: v.VersionNumber,
+				AuthorID:      v.AuthorID,
+				CreatedAt:     v.CreatedAt,
+			}
+		}
+		for _, m := range matchLines(prevLines, lines) {
+			blame[m.b] = prevBlame[m.a]
+		}
+
+		prevLines, prevBlame = lines, blame
+	}
+
+	for i := range prevBlame {
+		prevBlame[i].LineNumber = i + 1
+		prevBlame[i].Content = prevLines[i]
+	}
+	if prevBlame == nil {
+		prevBlame = []BlameLine{}
+	}
+	return prevBlame
+}
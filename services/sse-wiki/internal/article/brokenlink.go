@@ -0,0 +1,270 @@
+package article
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+var (
+	// /files/<id> 文件链接
+	fileURLPattern = regexp.MustCompile(`/files/(\d+)\b`)
+	// uploads/<文件名> 文件存储路径（与 File.FilePath 对应）
+	uploadPathPattern = regexp.MustCompile(`\buploads/[^\s)"'<>?#]+`)
+)
+
+// 失效原因
+const (
+	brokenReasonMissing = "missing" // 目标不存在
+	brokenReasonDeleted = "deleted" // 目标文章在回收站中
+)
+
+// brokenLink 正文中的一处失效链接
+type brokenLink struct {
+	line     int
+	linkType string // title / article / file / upload / attachment
+	target   string
+	reason   string
+}
+
+func (l brokenLink) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"line":      l.line,
+		"link_type": l.linkType,
+		"target":    l.target,
+		"reason":    l.reason,
+	}
+}
+
+// GetBrokenLinkReport 获取模块（含子模块）中文章当前版本的失效链接报告
+// 检查指向不存在或已删除文章的内部链接，以及引用不存在的 File 记录的图片/文件
+// 只返回存在失效链接的文章
+// 权限要求：模块 admin 及以上（含从祖先模块继承的权限）
+func (s *ArticleService) GetBrokenLinkReport(moduleID uint, userID uint, userRole string) (map[string]interface{}, error) {
+	if !s.articleRepo.ModuleExists(moduleID) {
+		return nil, errors.New("模块不存在")
+	}
+	if !s.permissionService.CheckModulePermissionWithInheritance(moduleID, userID, userRole, "admin").HasPermission {
+		return nil, errors.New("permission denied: only module admins can view the broken link report")
+	}
+
+	moduleIDs, err := s.articleRepo.GetModuleSubtreeIDs(moduleID)
+	if err != nil {
+		return nil, err
+	}
+	articles, err := s.articleRepo.ListByModuleIDs(moduleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	versionIDs := make([]uint, 0, len(articles))
+	for _, art := range articles {
+		if art.CurrentVersionID != nil {
+			versionIDs = append(versionIDs, *art.CurrentVersionID)
+		}
+	}
+	missingAttachments, err := s.articleRepo.ListMissingVersionFiles(versionIDs)
+	if err != nil {
+		return nil, err
+	}
+	attachmentsByVersion := make(map[uint][]brokenLink)
+	for _, f := range missingAttachments {
+		attachmentsByVersion[f.VersionID] = append(attachmentsByVersion[f.VersionID], brokenLink{
+			linkType: "attachment",
+			target:   strconv.FormatUint(uint64(f.FileID), 10),
+			reason:   brokenReasonMissing,
+		})
+	}
+
+	// 先解析所有文章的链接，再统一批量查询链接目标，避免按文章或按链接逐个查询
+	type scannedArticle struct {
+		art        *article.Article
+		candidates []brokenLink
+	}
+	scanned := make([]scannedArticle, 0, len(articles))
+	var allCandidates []brokenLink
+	for i := range articles {
+		art := &articles[i]
+		if art.CurrentVersionID == nil {
+			continue
+		}
+		content, err := s.versionRepo.GetContent(*art.CurrentVersionID)
+		if err != nil {
+			return nil, err
+		}
+		candidates := scanLinkCandidates(content)
+		scanned = append(scanned, scannedArticle{art: art, candidates: candidates})
+		allCandidates = append(allCandidates, candidates...)
+	}
+	targets, err := s.resolveLinkTargets(allCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]interface{}, 0)
+	totalLinks, totalFiles := 0, 0
+	for _, sa := range scanned {
+		art := sa.art
+		links, files := targets.classify(sa.candidates)
+		files = append(files, attachmentsByVersion[*art.CurrentVersionID]...)
+		if len(links) == 0 && len(files) == 0 {
+			continue
+		}
+
+		linkMaps := make([]map[string]interface{}, len(links))
+		for j, l := range links {
+			linkMaps[j] = l.toMap()
+		}
+		fileMaps := make([]map[string]interface{}, len(files))
+		for j, f := range files {
+			fileMaps[j] = f.toMap()
+		}
+		totalLinks += len(links)
+		totalFiles += len(files)
+
+		items = append(items, map[string]interface{}{
+			"article_id":   art.ID,
+			"title":        art.Title,
+			"module_id":    art.ModuleID,
+			"version_id":   *art.CurrentVersionID,
+			"broken_links": linkMaps,
+			"broken_files": fileMaps,
+		})
+	}
+
+	return map[string]interface{}{
+		"module_id":         moduleID,
+		"articles_checked":  len(articles),
+		"broken_link_count": totalLinks,
+		"broken_file_count": totalFiles,
+		"articles":          items,
+	}, nil
+}
+
+// scanLinkCandidates 提取正文中的文章链接和文件引用（代码块中的内容忽略）
+func scanLinkCandidates(content string) []brokenLink {
+	var candidates []brokenLink
+	scanContentLines(content, func(lineNo int, line string) {
+		for _, m := range wikiTitleLinkPattern.FindAllStringSubmatch(line, -1) {
+			if title := strings.TrimSpace(m[1]); title != "" {
+				candidates = append(candidates, brokenLink{line: lineNo, linkType: "title", target: title})
+			}
+		}
+		for _, m := range articleURLPattern.FindAllStringSubmatch(line, -1) {
+			if _, err := strconv.ParseUint(m[1], 10, 64); err == nil {
+				candidates = append(candidates, brokenLink{line: lineNo, linkType: "article", target: m[1]})
+			}
+		}
+		for _, m := range fileURLPattern.FindAllStringSubmatch(line, -1) {
+			if _, err := strconv.ParseUint(m[1], 10, 64); err == nil {
+				candidates = append(candidates, brokenLink{line: lineNo, linkType: "file", target: m[1]})
+			}
+		}
+		for _, path := range uploadPathPattern.FindAllString(line, -1) {
+			candidates = append(candidates, brokenLink{line: lineNo, linkType: "upload", target: path})
+		}
+	})
+	return candidates
+}
+
+// linkTargets 链接目标的查询结果
+type linkTargets struct {
+	titles        map[string]bool // 标题 -> 是否有未删除的文章（不存在的标题不在 map 中）
+	articleByID   map[uint]article.Article
+	existingFiles map[uint]bool
+	existingPaths map[string]bool
+}
+
+// resolveLinkTargets 批量查询候选链接指向的文章和文件（每类目标一次查询）
+func (s *ArticleService) resolveLinkTargets(candidates []brokenLink) (*linkTargets, error) {
+	var titles, uploadPaths []string
+	var articleIDs, fileIDs []uint
+	seenTitles := make(map[string]bool)
+	for _, c := range candidates {
+		switch c.linkType {
+		case "title":
+			if !seenTitles[c.target] {
+				seenTitles[c.target] = true
+				titles = append(titles, c.target)
+			}
+		case "article":
+			id, _ := strconv.ParseUint(c.target, 10, 64)
+			articleIDs = append(articleIDs, uint(id))
+		case "file":
+			id, _ := strconv.ParseUint(c.target, 10, 64)
+			fileIDs = append(fileIDs, uint(id))
+		case "upload":
+			uploadPaths = append(uploadPaths, c.target)
+		}
+	}
+
+	titleStatuses, err := s.articleRepo.TitleStatuses(titles)
+	if err != nil {
+		return nil, err
+	}
+	linkedArticles, err := s.articleRepo.GetByIDsWithTrash(articleIDs)
+	if err != nil {
+		return nil, err
+	}
+	articleByID := make(map[uint]article.Article, len(linkedArticles))
+	for _, a := range linkedArticles {
+		articleByID[a.ID] = a
+	}
+	existingFiles, err := s.articleRepo.ExistingFileIDs(fileIDs)
+	if err != nil {
+		return nil, err
+	}
+	existingPaths, err := s.articleRepo.ExistingFilePaths(uploadPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	return &linkTargets{
+		titles:        titleStatuses,
+		articleByID:   articleByID,
+		existingFiles: existingFiles,
+		existingPaths: existingPaths,
+	}, nil
+}
+
+// classify 按查询结果筛选出失效的文章链接和文件引用
+func (t *linkTargets) classify(candidates []brokenLink) (links []brokenLink, files []brokenLink) {
+	for _, c := range candidates {
+		switch c.linkType {
+		case "title":
+			live, ok := t.titles[c.target]
+			if !ok {
+				c.reason = brokenReasonMissing
+				links = append(links, c)
+			} else if !live {
+				c.reason = brokenReasonDeleted
+				links = append(links, c)
+			}
+		case "article":
+			id, _ := strconv.ParseUint(c.target, 10, 64)
+			a, ok := t.articleByID[uint(id)]
+			if !ok {
+				c.reason = brokenReasonMissing
+				links = append(links, c)
+			} else if a.DeletedAt.Valid {
+				c.reason = brokenReasonDeleted
+				links = append(links, c)
+			}
+		case "file":
+			id, _ := strconv.ParseUint(c.target, 10, 64)
+			if !t.existingFiles[uint(id)] {
+				c.reason = brokenReasonMissing
+				files = append(files, c)
+			}
+		case "upload":
+			if !t.existingPaths[c.target] {
+				c.reason = brokenReasonMissing
+				files = append(files, c)
+			}
+		}
+	}
+	return links, files
+}
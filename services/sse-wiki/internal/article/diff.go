@@ -0,0 +1,293 @@
+package article
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lineMatch 两个行序列中一对相等行的下标
+type lineMatch struct {
+	a int
+	b int
+}
+
+// splitLines 按行切分文本，保留每行末尾的换行符，拼接后可无损还原原文
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// matchLines 计算 a、b 的最长公共子序列，按下标升序返回匹配的行对
+// 先剥离公共前后缀，再对中间部分执行 Myers 差分算法
+func matchLines(a, b []string) []lineMatch {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	matches := make([]lineMatch, 0, prefix+suffix)
+	for i := 0; i < prefix; i++ {
+		matches = append(matches, lineMatch{a: i, b: i})
+	}
+
+	for _, m := range myersMatches(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]) {
+		matches = append(matches, lineMatch{a: m.a + prefix, b: m.b + prefix})
+	}
+
+	for i := suffix; i > 0; i-- {
+		matches = append(matches, lineMatch{a: len(a) - i, b: len(b) - i})
+	}
+
+	return matches
+}
+
+// myersMatches Myers O(ND) 差分算法，返回 a、b 之间的匹配行对
+func myersMatches(a, b []string) []lineMatch {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return nil
+	}
+
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	trace := make([][]int, 0)
+
+	// 1. 前向搜索，记录每一步的 V 数组用于回溯
+search:
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	// 2. 从终点回溯，收集对角线（相等行）
+	var reversed []lineMatch
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		vd := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && vd[offset+k-1] < vd[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := vd[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, lineMatch{a: x, b: y})
+		}
+		if d > 0 {
+			x, y = prevX, prevY
+		}
+	}
+
+	matches := make([]lineMatch, len(reversed))
+	for i, m := range reversed {
+		matches[len(reversed)-1-i] = m
+	}
+	return matches
+}
+
+// DiffLine 差异中的一行
+// Type: context(未改动), added(新增), removed(删除)
+// Content 不含行尾换行符；OldLine/NewLine 为该行在旧/新版本中的行号（从1开始），不存在时为 0
+type DiffLine struct {
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	OldLine int    `json:"old_line"`
+	NewLine int    `json:"new_line"`
+	// 该行是文件最后一行且没有换行符（用于统一diff中的 "\ No newline at end of file"）
+	noNewline bool
+}
+
+// DiffHunk 差异区块，起始行号与统一diff格式（@@ -old_start,old_count +new_start,new_count @@）一致
+type DiffHunk struct {
+	OldStart int        `json:"old_start"`
+	OldCount int        `json:"old_count"`
+	NewStart int        `json:"new_start"`
+	NewCount int        `json:"new_count"`
+	Lines    []DiffLine `json:"lines"`
+}
+
+// DiffResult 两个版本之间的行级差异
+type DiffResult struct {
+	Hunks        []DiffHunk `json:"hunks"`
+	AddedLines   int        `json:"added_lines"`
+	RemovedLines int        `json:"removed_lines"`
+}
+
+// LineDiff 计算 from -> to 的行级差异，contextLines 为每个区块前后保留的未改动行数
+func (s *MergeService) LineDiff(from, to string, contextLines int) DiffResult {
+	if contextLines < 0 {
+		contextLines = 0
+	}
+
+	fromLines := splitLines(from)
+	toLines := splitLines(to)
+
+	// 1. 将匹配结果展开为逐行编辑脚本
+	var ops []DiffLine
+	i, j := 0, 0
+	appendChanges := func(untilOld, untilNew int) {
+		for ; i < untilOld; i++ {
+			ops = append(ops, newDiffLine("removed", fromLines[i], i+1, 0))
+		}
+		for ; j < untilNew; j++ {
+			ops = append(ops, newDiffLine("added", toLines[j], 0, j+1))
+		}
+	}
+	for _, m := range matchLines(fromLines, toLines) {
+		appendChanges(m.a, m.b)
+		ops = append(ops, newDiffLine("context", fromLines[i], i+1, j+1))
+		i++
+		j++
+	}
+	appendChanges(len(fromLines), len(toLines))
+
+	// 2. 统计并找出所有修改行的位置
+	result := DiffResult{Hunks: []DiffHunk{}}
+	var changes []int
+	for idx, op := range ops {
+		switch op.Type {
+		case "added":
+			result.AddedLines++
+			changes = append(changes, idx)
+		case "removed":
+			result.RemovedLines++
+			changes = append(changes, idx)
+		}
+	}
+	if len(changes) == 0 {
+		return result
+	}
+
+	// 3. 相邻修改之间的未改动行不超过 2*contextLines 时合并为同一区块
+	hunkStart := changes[0]
+	lastChange := changes[0]
+	for _, c := range changes[1:] {
+		if c-lastChange-1 > 2*contextLines {
+			result.Hunks = append(result.Hunks, buildDiffHunk(ops, hunkStart, lastChange, contextLines))
+			hunkStart = c
+		}
+		lastChange = c
+	}
+	result.Hunks = append(result.Hunks, buildDiffHunk(ops, hunkStart, lastChange, contextLines))
+
+	return result
+}
+
+func newDiffLine(lineType, raw string, oldLine, newLine int) DiffLine {
+	content := strings.TrimSuffix(raw, "\n")
+	return DiffLine{
+		Type:      lineType,
+		Content:   content,
+		OldLine:   oldLine,
+		NewLine:   newLine,
+		noNewline: content == raw,
+	}
+}
+
+// buildDiffHunk 以 ops[first..last] 的修改为中心，加上前后上下文构造区块
+func buildDiffHunk(ops []DiffLine, first, last, contextLines int) DiffHunk {
+	start := first - contextLines
+	if start < 0 {
+		start = 0
+	}
+	end := last + contextLines + 1
+	if end > len(ops) {
+		end = len(ops)
+	}
+
+	hunk := DiffHunk{Lines: append([]DiffLine(nil), ops[start:end]...)}
+
+	// 统计行数，并计算区块前已有的旧/新行数作为空区间的起始行号
+	oldBefore, newBefore := 0, 0
+	for _, op := range ops[:start] {
+		if op.Type != "added" {
+			oldBefore++
+		}
+		if op.Type != "removed" {
+			newBefore++
+		}
+	}
+	for _, line := range hunk.Lines {
+		if line.Type != "added" {
+			hunk.OldCount++
+		}
+		if line.Type != "removed" {
+			hunk.NewCount++
+		}
+	}
+
+	hunk.OldStart = oldBefore
+	if hunk.OldCount > 0 {
+		hunk.OldStart++
+	}
+	hunk.NewStart = newBefore
+	if hunk.NewCount > 0 {
+		hunk.NewStart++
+	}
+	return hunk
+}
+
+// UnifiedDiff 将差异渲染为统一diff格式文本
+func (d DiffResult) UnifiedDiff(fromLabel, toLabel string) string {
+	if len(d.Hunks) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromLabel, toLabel)
+	for _, hunk := range d.Hunks {
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount)
+		for _, line := range hunk.Lines {
+			switch line.Type {
+			case "added":
+				sb.WriteByte('+')
+			case "removed":
+				sb.WriteByte('-')
+			default:
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(line.Content)
+			sb.WriteByte('\n')
+			if line.noNewline {
+				sb.WriteString("\\ No newline at end of file\n")
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,158 @@
+package article
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// SaveDraft 保存个人草稿（自动保存）
+// 草稿不创建 ArticleVersion，只有提交草稿时才进入正常的提交/审核流程
+// 基础版本规则：请求指定时使用指定版本；否则沿用已有草稿的基础版本；新草稿以文章当前版本为基础
+func (s *ArticleService) SaveDraft(articleID uint, userID uint, req dto.SaveDraftRequest) (map[string]interface{}, error) {
+	art, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, errors.New("文章不存在")
+	}
+
+	draft, err := s.articleRepo.GetDraft(articleID, userID)
+	if err != nil {
+		// 新草稿
+		draft = &article.ArticleDraft{
+			ArticleID: articleID,
+			UserID:    userID,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	baseVersionID := req.BaseVersionID
+	if baseVersionID == 0 {
+		baseVersionID = draft.BaseVersionID
+	}
+	if baseVersionID == 0 {
+		if art.CurrentVersionID == nil {
+			return nil, errors.New("文章没有当前版本，数据可能已损坏")
+		}
+		baseVersionID = *art.CurrentVersionID
+	}
+
+	// 基础版本必须是该文章已发布的版本
+	baseVersion, err := s.versionRepo.GetByID(baseVersionID)
+	if err != nil || baseVersion.ArticleID != articleID {
+		return nil, errors.New("基础版本不存在")
+	}
+	if baseVersion.Status != "published" {
+		return nil, errors.New("草稿只能基于已发布的版本")
+	}
+
+	draft.BaseVersionID = baseVersionID
+	draft.Content = req.Content
+	draft.CommitMessage = req.CommitMessage
+	draft.UpdatedAt = time.Now()
+
+	if err := s.articleRepo.SaveDraft(draft); err != nil {
+		return nil, err
+	}
+	return s.buildDraftInfo(draft, art), nil
+}
+
+// GetDraft 获取当前用户在文章下的草稿
+// 返回草稿及其基础版本号，is_outdated 表示文章在草稿开始编辑后已发布了新版本
+func (s *ArticleService) GetDraft(articleID uint, userID uint) (map[string]interface{}, error) {
+	art, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, errors.New("文章不存在")
+	}
+
+	draft, err := s.articleRepo.GetDraft(articleID, userID)
+	if err != nil {
+		return nil, errors.New("草稿不存在")
+	}
+
+	return s.buildDraftInfo(draft, art), nil
+}
+
+// DiscardDraft 丢弃当前用户在文章下的草稿
+func (s *ArticleService) DiscardDraft(articleID uint, userID uint) error {
+	deleted, err := s.articleRepo.DeleteDraft(articleID, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.New("草稿不存在")
+	}
+	return nil
+}
+
+// ListMyDrafts 获取当前用户的所有草稿
+func (s *ArticleService) ListMyDrafts(userID uint) ([]map[string]interface{}, error) {
+	drafts, err := s.articleRepo.ListDraftsByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, 0, len(drafts))
+	for i := range drafts {
+		art, err := s.articleRepo.GetByID(drafts[i].ArticleID)
+		if err != nil {
+			continue
+		}
+		result = append(result, s.buildDraftInfo(&drafts[i], art))
+	}
+	return result, nil
+}
+
+// SubmitDraft 将草稿作为普通提交发起（发布规则与 CreateSubmission 一致）
+// commitMessage 为空时使用草稿中保存的提交信息；提交成功后删除草稿，发生合并冲突时保留草稿
+func (s *ArticleService) SubmitDraft(articleID uint, commitMessage string, userID uint, userRole string) (*article.ReviewSubmission, *article.ArticleVersion, error) {
+	draft, err := s.articleRepo.GetDraft(articleID, userID)
+	if err != nil {
+		return nil, nil, errors.New("草稿不存在")
+	}
+
+	if commitMessage == "" {
+		commitMessage = draft.CommitMessage
+	}
+	if commitMessage == "" {
+		return nil, nil, errors.New("提交信息不能为空")
+	}
+
+	submission, publishedVersion, err := s.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       draft.Content,
+		CommitMessage: commitMessage,
+		BaseVersionID: draft.BaseVersionID,
+	}, userID, userRole)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := s.articleRepo.DeleteDraft(articleID, userID); err != nil {
+		// TODO: 生产环境优化 - 移除或使用结构化日志
+		log.Printf("[SubmitDraft] 提交成功但删除草稿失败, articleID=%d, userID=%d, err=%v", articleID, userID, err)
+	}
+
+	return submission, publishedVersion, nil
+}
+
+// buildDraftInfo 构造草稿返回数据
+func (s *ArticleService) buildDraftInfo(draft *article.ArticleDraft, art *article.Article) map[string]interface{} {
+	baseVersionNumber, _ := s.versionRepo.GetVersionNumber(draft.BaseVersionID)
+	isOutdated := art.CurrentVersionID != nil && *art.CurrentVersionID != draft.BaseVersionID
+
+	return map[string]interface{}{
+		"id":                  draft.ID,
+		"article_id":          draft.ArticleID,
+		"article_title":       art.Title,
+		"user_id":             draft.UserID,
+		"base_version_id":     draft.BaseVersionID,
+		"base_version_number": baseVersionNumber,
+		"content":             draft.Content,
+		"commit_message":      draft.CommitMessage,
+		"is_outdated":         isOutdated,
+		"created_at":          draft.CreatedAt,
+		"updated_at":          draft.UpdatedAt,
+	}
+}
@@ -0,0 +1,218 @@
+package article
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// FeedFormatAtom Atom 1.0 订阅格式
+	FeedFormatAtom = "atom"
+	// FeedFormatRSS RSS 2.0 订阅格式
+	FeedFormatRSS = "rss"
+
+	// FeedTypeModule 模块（含子模块）最近发布的版本
+	FeedTypeModule = "module"
+	// FeedTypeArticle 单篇文章的修订历史
+	FeedTypeArticle = "article"
+)
+
+// feedSiteURL 订阅源中链接使用的站点地址（为空时使用相对链接）
+var feedSiteURL = ""
+
+// SetFeedSiteURL 设置订阅源中链接使用的站点地址
+func SetFeedSiteURL(siteURL string) {
+	feedSiteURL = strings.TrimRight(siteURL, "/")
+}
+
+// atomFeed Atom 1.0 文档
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated string     `xml:"updated"`
+	Link    atomLink   `xml:"link"`
+	Author  atomAuthor `xml:"author"`
+	Summary string     `xml:"summary"`
+}
+
+// rssFeed RSS 2.0 文档
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+// GetFeed 生成订阅源（Atom 或 RSS）
+// feedType 为 module 时返回模块及其子模块中最近发布的版本，为 article 时返回文章的修订历史
+// 条目包含文章标题、版本号、提交信息、作者和发布时间，不包含正文；回收站中的文章不返回
+// 无需登录：已发布版本的摘要对游客公开（与 GetActivityFeed 的 version_published 一致），便于阅读器直接订阅
+func (s *ArticleService) GetFeed(feedType string, id uint, format string, limit int) (map[string]interface{}, error) {
+	if format == "" {
+		format = FeedFormatAtom
+	}
+	if format != FeedFormatAtom && format != FeedFormatRSS {
+		return nil, errors.New("无效的订阅格式")
+	}
+
+	var title, description, feedID, link string
+	var entries []FeedEntry
+	switch feedType {
+	case FeedTypeModule:
+		mod, err := s.articleRepo.GetModule(id)
+		if err != nil {
+			return nil, errors.New("模块不存在")
+		}
+		moduleIDs, err := s.articleRepo.GetModuleSubtreeIDs(id)
+		if err != nil {
+			return nil, err
+		}
+		if entries, err = s.articleRepo.ListFeedEntries(moduleIDs, 0, limit); err != nil {
+			return nil, err
+		}
+		title = mod.ModuleName + " - 最近更新"
+		description = fmt.Sprintf("模块「%s」及其子模块中最近发布的文章版本", mod.ModuleName)
+		feedID = fmt.Sprintf("urn:sse-wiki:module:%d", id)
+		link = fmt.Sprintf("%s/modules/%d", feedSiteURL, id)
+
+	case FeedTypeArticle:
+		art, err := s.articleRepo.GetByID(id)
+		if err != nil {
+			return nil, errors.New("文章不存在")
+		}
+		if entries, err = s.articleRepo.ListFeedEntries(nil, id, limit); err != nil {
+			return nil, err
+		}
+		title = art.Title + " - 修订历史"
+		description = fmt.Sprintf("文章「%s」的修订历史", art.Title)
+		feedID = fmt.Sprintf("urn:sse-wiki:article:%d", id)
+		link = fmt.Sprintf("%s/articles/%d", feedSiteURL, id)
+
+	default:
+		return nil, errors.New("无效的订阅类型")
+	}
+
+	// 订阅源更新时间取最新条目的发布时间
+	updated := time.Now()
+	if len(entries) > 0 {
+		updated = entries[0].PublishedAt
+	}
+
+	var doc interface{}
+	contentType := "application/atom+xml; charset=utf-8"
+	if format == FeedFormatAtom {
+		feed := atomFeed{
+			Xmlns:   "http://www.w3.org/2005/Atom",
+			ID:      feedID,
+			Title:   title,
+			Updated: updated.UTC().Format(time.RFC3339),
+			Link:    atomLink{Href: link},
+			Entries: make([]atomEntry, len(entries)),
+		}
+		for i, entry := range entries {
+			feed.Entries[i] = atomEntry{
+				ID:      fmt.Sprintf("urn:sse-wiki:version:%d", entry.VersionID),
+				Title:   feedEntryTitle(feedType, &entry),
+				Updated: entry.PublishedAt.UTC().Format(time.RFC3339),
+				Link:    atomLink{Href: feedEntryLink(&entry)},
+				Author:  atomAuthor{Name: feedAuthorName(&entry)},
+				Summary: entry.CommitMessage,
+			}
+		}
+		doc = feed
+	} else {
+		contentType = "application/rss+xml; charset=utf-8"
+		channel := rssChannel{
+			Title:         title,
+			Link:          link,
+			Description:   description,
+			LastBuildDate: updated.Format(time.RFC1123Z),
+			Items:         make([]rssItem, len(entries)),
+		}
+		for i, entry := range entries {
+			channel.Items[i] = rssItem{
+				Title:       feedEntryTitle(feedType, &entry),
+				Link:        feedEntryLink(&entry),
+				Description: fmt.Sprintf("%s（作者：%s）", entry.CommitMessage, feedAuthorName(&entry)),
+				GUID:        rssGUID{IsPermaLink: "false", Value: fmt.Sprintf("urn:sse-wiki:version:%d", entry.VersionID)},
+				PubDate:     entry.PublishedAt.Format(time.RFC1123Z),
+			}
+		}
+		doc = rssFeed{Version: "2.0", Channel: channel}
+	}
+
+	content, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"content_type": contentType,
+		"content":      xml.Header + string(content),
+	}, nil
+}
+
+// feedEntryTitle 条目标题：模块订阅包含文章标题，文章订阅使用版本号和提交信息
+func feedEntryTitle(feedType string, entry *FeedEntry) string {
+	if feedType == FeedTypeModule {
+		return fmt.Sprintf("%s (v%d)", entry.ArticleTitle, entry.VersionNumber)
+	}
+	if entry.CommitMessage == "" {
+		return fmt.Sprintf("v%d", entry.VersionNumber)
+	}
+	return fmt.Sprintf("v%d: %s", entry.VersionNumber, entry.CommitMessage)
+}
+
+// feedEntryLink 条目链接（指向文章的指定版本）
+func feedEntryLink(entry *FeedEntry) string {
+	return fmt.Sprintf("%s/articles/%d?version=%d", feedSiteURL, entry.ArticleID, entry.VersionNumber)
+}
+
+// feedAuthorName 条目作者名（用户不存在时使用用户ID）
+func feedAuthorName(entry *FeedEntry) string {
+	if entry.AuthorName != "" {
+		return entry.AuthorName
+	}
+	return fmt.Sprintf("user-%d", entry.AuthorID)
+}
@@ -1,65 +1,190 @@
 package article
 
+import "strings"
+
 type MergeService struct{}
 
 // MergeResult 3路合并结果
 // 说明：
 // - HasConflict: 是否有冲突（后端检测）
 // - MergedContent: 自动合并后的内容（仅当无冲突时有值）
-// 注意：冲突标记的生成已移至前端，后端只返回三方原始内容（base, their, our）
+// - Conflicts: 冲突区块列表（仅当有冲突时有值）
+// 注意：冲突标记的生成在前端完成，后端返回冲突区块的位置和三方内容
 type MergeResult struct {
-	HasConflict   bool   `json:"has_conflict"`
-	MergedContent string `json:"merged_content"` // 仅当 HasConflict=false 时有值
+	HasConflict   bool           `json:"has_conflict"`
+	MergedContent string         `json:"merged_content"` // 仅当 HasConflict=false 时有值
+	Conflicts     []ConflictHunk `json:"conflicts,omitempty"`
+}
+
+// ConflictHunk 冲突区块
+// 行号从1开始，Start 为该区块在对应版本中的起始行，Count 为行数
+// Count 为 0 表示该方在此位置没有内容（删除或未插入），此时 Start 指向其后的第一行
+type ConflictHunk struct {
+	BaseStart    int    `json:"base_start"`
+	BaseCount    int    `json:"base_count"`
+	TheirStart   int    `json:"their_start"`
+	TheirCount   int    `json:"their_count"`
+	OurStart     int    `json:"our_start"`
+	OurCount     int    `json:"our_count"`
+	BaseContent  string `json:"base_content"`
+	TheirContent string `json:"their_content"`
+	OurContent   string `json:"our_content"`
+}
+
+// mergeChunk diff3 切分出的一个区块
+// stable=true 表示三方在该区块内容一致；否则为至少一方修改过的区块
+// 各区间均为左闭右开的行下标
+type mergeChunk struct {
+	stable     bool
+	baseStart  int
+	baseEnd    int
+	theirStart int
+	theirEnd   int
+	ourStart   int
+	ourEnd     int
 }
 
 func NewMergeService() *MergeService {
 	return &MergeService{}
 }
 
-// ThreeWayMerge 执行3路合并
+// ThreeWayMerge 执行3路合并（行级 diff3 语义）
 // base: 共同祖先版本
 // theirs: 提交者的修改
 // ours: 当前线上版本
-// TODO: 合并算法优化 - 当前实现是简化版的字符串级合并，未来可以实现更智能的行级合并或语义级合并
+// 只有两方修改了同一区域（或相邻且中间没有未改动的行）且修改内容不同时才视为冲突，
+// 互不重叠的修改会自动合并
 func (s *MergeService) ThreeWayMerge(base, theirs, ours string) MergeResult {
-	// 1. 检查是否有实质性的修改冲突
-	// 如果 theirs 和 ours 都不等于 base，且它们也不相等，说明有冲突
-	theirsChanged := theirs != base
-	oursChanged := ours != base
-
-	if theirsChanged && oursChanged && theirs != ours {
-		// 两者都改了，且改的不一样 → 冲突！
-		return MergeResult{HasConflict: true}
+	// 快速路径：整体未修改或修改一致
+	if theirs == ours || ours == base {
+		return MergeResult{HasConflict: false, MergedContent: theirs}
 	}
+	if theirs == base {
+		return MergeResult{HasConflict: false, MergedContent: ours}
+	}
+
+	baseLines := splitLines(base)
+	theirLines := splitLines(theirs)
+	ourLines := splitLines(ours)
 
-	// 4. 尝试自动合并（只在其中一方修改时）
-	if !theirsChanged && oursChanged {
-		// 只有 ours 改了，使用 ours
-		return MergeResult{
-			HasConflict:   false,
-			MergedContent: ours,
+	var merged strings.Builder
+	var conflicts []ConflictHunk
+	for _, chunk := range diff3Chunks(baseLines, theirLines, ourLines) {
+		if chunk.stable {
+			writeLines(&merged, baseLines[chunk.baseStart:chunk.baseEnd])
+			continue
 		}
-	}
 
-	if theirsChanged && !oursChanged {
-		// 只有 theirs 改了，使用 theirs
-		return MergeResult{
-			HasConflict:   false,
-			MergedContent: theirs,
+		basePart := baseLines[chunk.baseStart:chunk.baseEnd]
+		theirPart := theirLines[chunk.theirStart:chunk.theirEnd]
+		ourPart := ourLines[chunk.ourStart:chunk.ourEnd]
+
+		switch {
+		case linesEqual(ourPart, basePart):
+			// 只有提交者修改了该区块
+			writeLines(&merged, theirPart)
+		case linesEqual(theirPart, basePart):
+			// 只有线上版本修改了该区块
+			writeLines(&merged, ourPart)
+		case linesEqual(theirPart, ourPart):
+			// 双方做了相同的修改
+			writeLines(&merged, theirPart)
+		default:
+			conflicts = append(conflicts, ConflictHunk{
+				BaseStart:    chunk.baseStart + 1,
+				BaseCount:    len(basePart),
+				TheirStart:   chunk.theirStart + 1,
+				TheirCount:   len(theirPart),
+				OurStart:     chunk.ourStart + 1,
+				OurCount:     len(ourPart),
+				BaseContent:  strings.Join(basePart, ""),
+				TheirContent: strings.Join(theirPart, ""),
+				OurContent:   strings.Join(ourPart, ""),
+			})
 		}
 	}
 
-	// 5. 两者都没改，或者改的一样
-	if theirs == ours {
-		return MergeResult{
-			HasConflict:   false,
-			MergedContent: theirs,
-		}
+	if len(conflicts) > 0 {
+		return MergeResult{HasConflict: true, Conflicts: conflicts}
 	}
 
-	// 默认返回 theirs（防止为空）
 	return MergeResult{
 		HasConflict:   false,
-		MergedContent: theirs,
+		MergedContent: merged.String(),
+	}
+}
+
+// diff3Chunks 以 base 为参照，将三方内容切分为稳定区块和修改区块
+func diff3Chunks(base, theirs, ours []string) []mergeChunk {
+	theirOf := alignToBase(len(base), matchLines(base, theirs))
+	ourOf := alignToBase(len(base), matchLines(base, ours))
+
+	var chunks []mergeChunk
+	b, t, o := 0, 0, 0
+	for b < len(base) || t < len(theirs) || o < len(ours) {
+		// 1. 尽可能延伸三方一致的稳定区块
+		n := 0
+		for b+n < len(base) && theirOf[b+n] == t+n && ourOf[b+n] == o+n {
+			n++
+		}
+		if n > 0 {
+			chunks = append(chunks, mergeChunk{
+				stable:    true,
+				baseStart: b, baseEnd: b + n,
+				theirStart: t, theirEnd: t + n,
+				ourStart: o, ourEnd: o + n,
+			})
+			b, t, o = b+n, t+n, o+n
+			continue
+		}
+
+		// 2. 找到下一处三方都匹配的 base 行，中间部分即为修改区块
+		next := b
+		for next < len(base) && (theirOf[next] < 0 || ourOf[next] < 0) {
+			next++
+		}
+
+		chunk := mergeChunk{baseStart: b, baseEnd: next, theirStart: t, ourStart: o}
+		if next < len(base) {
+			chunk.theirEnd = theirOf[next]
+			chunk.ourEnd = ourOf[next]
+		} else {
+			chunk.theirEnd = len(theirs)
+			chunk.ourEnd = len(ours)
+		}
+		chunks = append(chunks, chunk)
+		b, t, o = chunk.baseEnd, chunk.theirEnd, chunk.ourEnd
+	}
+
+	return chunks
+}
+
+// alignToBase 将匹配结果转换为 base 行号 -> 另一方行号的映射，未匹配的行为 -1
+func alignToBase(baseLen int, matches []lineMatch) []int {
+	aligned := make([]int, baseLen)
+	for i := range aligned {
+		aligned[i] = -1
+	}
+	for _, m := range matches {
+		aligned[m.a] = m.b
+	}
+	return aligned
+}
+
+func linesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLines(sb *strings.Builder, lines []string) {
+	for _, line := range lines {
+		sb.WriteString(line)
 	}
 }
@@ -0,0 +1,58 @@
+package article
+
+import (
+	"errors"
+	"log"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// MoveArticle 将文章移动到其他模块
+// 权限要求：对源模块和目标模块都具有 moderator 及以上权限（含从祖先模块继承的权限）
+// 移动记录写入 article_moves，出现在文章历史中，并用于解析旧模块下的文章路径
+func (s *ArticleService) MoveArticle(articleID uint, targetModuleID uint, userID uint, userRole string) (*article.ArticleMove, error) {
+	art, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, errors.New("文章不存在")
+	}
+	if !s.articleRepo.ModuleExists(targetModuleID) {
+		return nil, errors.New("目标模块不存在")
+	}
+	if art.ModuleID == targetModuleID {
+		return nil, errors.New("文章已在目标模块中")
+	}
+
+	if !s.permissionService.CheckModulePermissionWithInheritance(art.ModuleID, userID, userRole, "moderator").HasPermission {
+		return nil, errors.New("permission denied: moderator permission on the source module is required")
+	}
+	if !s.permissionService.CheckModulePermissionWithInheritance(targetModuleID, userID, userRole, "moderator").HasPermission {
+		return nil, errors.New("permission denied: moderator permission on the target module is required")
+	}
+
+	move, err := s.articleRepo.MoveToModule(art, targetModuleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[MoveArticle] 文章已移动, articleID=%d, from=%d, to=%d, movedBy=%d",
+		articleID, move.FromModuleID, move.ToModuleID, userID)
+
+	return move, nil
+}
+
+// ResolveArticleModule 解析旧模块路径下的文章
+// 文章当前位于该模块，或曾经从该模块移出时返回文章当前所属模块；moved 表示路径已过时，需要跳转
+func (s *ArticleService) ResolveArticleModule(moduleID uint, articleID uint) (currentModuleID uint, moved bool, err error) {
+	art, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return 0, false, errors.New("文章不存在")
+	}
+	if art.ModuleID == moduleID {
+		return art.ModuleID, false, nil
+	}
+	if s.articleRepo.HasMovedFrom(articleID, moduleID) {
+		return art.ModuleID, true, nil
+	}
+	return 0, false, errors.New("文章不存在")
+}
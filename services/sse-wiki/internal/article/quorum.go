@@ -0,0 +1,75 @@
+package article
+
+import (
+	"errors"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// voteTally 提交的投票统计
+type voteTally struct {
+	Required   int
+	Approvals  int
+	Rejections int
+}
+
+// approved 通过票扣除驳回票后达到通过人数时合并
+// 即任何驳回都会阻止合并，直到有足够多的通过票推翻它
+func (t voteTally) approved() bool {
+	return t.Approvals-t.Rejections >= t.Required
+}
+
+// rejected 驳回票达到通过人数时驳回提交（单人审核时一票驳回）
+func (t voteTally) rejected() bool {
+	return t.Rejections >= t.Required
+}
+
+func (t voteTally) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"required_approvals": t.Required,
+		"approvals":          t.Approvals,
+		"rejections":         t.Rejections,
+	}
+}
+
+// recordVote 记录审核人对提交的投票并返回最新统计
+func (s *ArticleService) recordVote(submission *article.ReviewSubmission, art *article.Article, reviewerID uint, req dto.ReviewActionRequest) (voteTally, error) {
+	if submission.Status == "merged" || submission.Status == "rejected" {
+		return voteTally{}, errors.New("该提交已审核完成，无法投票")
+	}
+
+	now := time.Now()
+	vote := &article.ReviewVote{
+		SubmissionID: submission.ID,
+		ReviewerID:   reviewerID,
+		Vote:         req.Action,
+		Notes:        req.Notes,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.submissionRepo.SaveVote(vote); err != nil {
+		return voteTally{}, err
+	}
+
+	votes, err := s.submissionRepo.ListVotes(submission.ID)
+	if err != nil {
+		return voteTally{}, err
+	}
+	return s.tallyVotes(art, votes), nil
+}
+
+// tallyVotes 统计投票，通过人数由文章设置或继承自模块
+func (s *ArticleService) tallyVotes(art *article.Article, votes []article.ReviewVote) voteTally {
+	tally := voteTally{Required: s.articleRepo.GetRequiredApprovals(art)}
+	for _, v := range votes {
+		switch v.Vote {
+		case "approve":
+			tally.Approvals++
+		case "reject":
+			tally.Rejections++
+		}
+	}
+	return tally
+}
@@ -0,0 +1,285 @@
+package article
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// 引用类型
+const (
+	ReferenceTypePrerequisite = "prerequisite" // 前置知识：被引用文章应先阅读
+	ReferenceTypeRelated      = "related"      // 相关文章
+	ReferenceTypeExtends      = "extends"      // 扩展阅读
+)
+
+var validReferenceTypes = map[string]bool{
+	ReferenceTypePrerequisite: true,
+	ReferenceTypeRelated:      true,
+	ReferenceTypeExtends:      true,
+}
+
+// AddReference 添加文章引用关系（from 引用 to）
+// 权限要求：源文章的 Author/Admin/Moderator
+// prerequisite 引用不允许形成循环
+func (s *ArticleService) AddReference(fromArticleID, toArticleID uint, refType string, userID uint) (*article.ArticleReference, error) {
+	if !validReferenceTypes[refType] {
+		return nil, errors.New("无效的引用类型")
+	}
+	if fromArticleID == toArticleID {
+		return nil, errors.New("不能引用文章自身")
+	}
+	if _, err := s.articleRepo.GetByID(fromArticleID); err != nil {
+		return nil, errors.New("文章不存在")
+	}
+	if _, err := s.articleRepo.GetByID(toArticleID); err != nil {
+		return nil, errors.New("被引用文章不存在")
+	}
+	if !s.articleRepo.CheckPermission(fromArticleID, userID, "", "moderator") {
+		return nil, errors.New("permission denied: only article moderators can manage references")
+	}
+	// 自动生成的引用可以被手动引用覆盖（例如将正文链接标记为前置知识）
+	existing, err := s.articleRepo.GetReference(fromArticleID, toArticleID)
+	replaceAuto := err == nil && existing.IsAuto
+	if err == nil && !replaceAuto {
+		return nil, errors.New("引用关系已存在")
+	}
+
+	if refType == ReferenceTypePrerequisite {
+		// to 的前置知识链中已包含 from 时，再添加 from -> to 会形成循环
+		reachable, err := s.prerequisiteReachable(toArticleID, fromArticleID)
+		if err != nil {
+			return nil, err
+		}
+		if reachable {
+			return nil, errors.New("前置关系会形成循环")
+		}
+	}
+
+	ref := &article.ArticleReference{
+		FromArticleID: fromArticleID,
+		ToArticleID:   toArticleID,
+		ReferenceType: refType,
+		CreatedAt:     time.Now(),
+	}
+	if replaceAuto {
+		ref.CreatedAt = existing.CreatedAt
+		err = s.articleRepo.UpdateReference(ref)
+	} else {
+		err = s.articleRepo.CreateReference(ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[AddReference] 添加引用, from=%d, to=%d, type=%s, userID=%d", fromArticleID, toArticleID, refType, userID)
+	return ref, nil
+}
+
+// RemoveReference 删除文章引用关系
+// 权限要求：源文章的 Author/Admin/Moderator
+func (s *ArticleService) RemoveReference(fromArticleID, toArticleID uint, userID uint) error {
+	if _, err := s.articleRepo.GetByID(fromArticleID); err != nil {
+		return errors.New("文章不存在")
+	}
+	if !s.articleRepo.CheckPermission(fromArticleID, userID, "", "moderator") {
+		return errors.New("permission denied: only article moderators can manage references")
+	}
+
+	deleted, err := s.articleRepo.DeleteReference(fromArticleID, toArticleID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.New("引用关系不存在")
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[RemoveReference] 删除引用, from=%d, to=%d, userID=%d", fromArticleID, toArticleID, userID)
+	return nil
+}
+
+// GetArticleReferences 获取文章的引用（references）和反向链接（backlinks）
+// refType 为空时返回所有类型
+func (s *ArticleService) GetArticleReferences(articleID uint, refType string) (map[string]interface{}, error) {
+	if refType != "" && !validReferenceTypes[refType] {
+		return nil, errors.New("无效的引用类型")
+	}
+	if _, err := s.articleRepo.GetByID(articleID); err != nil {
+		return nil, errors.New("文章不存在")
+	}
+
+	outgoing, err := s.articleRepo.ListOutgoingReferences(articleID, refType)
+	if err != nil {
+		return nil, err
+	}
+	incoming, err := s.articleRepo.ListBacklinks(articleID, refType)
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := s.buildReferenceItems(outgoing, func(ref article.ArticleReference) uint { return ref.ToArticleID })
+	if err != nil {
+		return nil, err
+	}
+	backlinks, err := s.buildReferenceItems(incoming, func(ref article.ArticleReference) uint { return ref.FromArticleID })
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"article_id": articleID,
+		"references": references,
+		"backlinks":  backlinks,
+	}, nil
+}
+
+// GetLearningPath 获取文章的学习路径
+// 沿 prerequisite 引用递归展开前置知识，按"先读前置"的顺序返回，目标文章位于最后；
+// level 为该文章下方最长前置链的长度（0 表示无需前置知识），同一 level 的文章可并行阅读。
+// 检测到循环时跳过造成循环的边，并在 cycles 中返回循环上的文章ID
+func (s *ArticleService) GetLearningPath(articleID uint) (map[string]interface{}, error) {
+	if _, err := s.articleRepo.GetByID(articleID); err != nil {
+		return nil, errors.New("文章不存在")
+	}
+
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[uint]int)
+	level := make(map[uint]int)
+	var order []uint
+	var stack []uint
+	var cycles [][]uint
+
+	var visit func(id uint) error
+	visit = func(id uint) error {
+		state[id] = visiting
+		stack = append(stack, id)
+
+		refs, err := s.articleRepo.ListOutgoingReferences(id, ReferenceTypePrerequisite)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			next := ref.ToArticleID
+			switch state[next] {
+			case visiting:
+				// 回边：栈中从 next 到当前文章构成循环
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == next {
+						cycles = append(cycles, append([]uint(nil), stack[i:]...))
+						break
+					}
+				}
+				continue
+			case 0:
+				if err := visit(next); err != nil {
+					return err
+				}
+			}
+			if level[next]+1 > level[id] {
+				level[id] = level[next] + 1
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[id] = done
+		order = append(order, id)
+		return nil
+	}
+	if err := visit(articleID); err != nil {
+		return nil, err
+	}
+
+	articles, err := s.articleRepo.GetByIDs(order)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]article.Article, len(articles))
+	for _, art := range articles {
+		byID[art.ID] = art
+	}
+
+	path := make([]map[string]interface{}, 0, len(order))
+	for _, id := range order {
+		art, ok := byID[id]
+		if !ok {
+			continue
+		}
+		path = append(path, map[string]interface{}{
+			"article_id": art.ID,
+			"title":      art.Title,
+			"module_id":  art.ModuleID,
+			"level":      level[id],
+		})
+	}
+
+	return map[string]interface{}{
+		"article_id": articleID,
+		"path":       path,
+		"has_cycle":  len(cycles) > 0,
+		"cycles":     cycles,
+	}, nil
+}
+
+// prerequisiteReachable 检查沿 prerequisite 引用能否从 from 到达 target
+func (s *ArticleService) prerequisiteReachable(from, target uint) (bool, error) {
+	visited := map[uint]bool{from: true}
+	queue := []uint{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == target {
+			return true, nil
+		}
+		refs, err := s.articleRepo.ListOutgoingReferences(id, ReferenceTypePrerequisite)
+		if err != nil {
+			return false, err
+		}
+		for _, ref := range refs {
+			if !visited[ref.ToArticleID] {
+				visited[ref.ToArticleID] = true
+				queue = append(queue, ref.ToArticleID)
+			}
+		}
+	}
+	return false, nil
+}
+
+// buildReferenceItems 组装引用列表，otherID 返回引用关系另一端的文章ID
+func (s *ArticleService) buildReferenceItems(refs []article.ArticleReference, otherID func(article.ArticleReference) uint) ([]map[string]interface{}, error) {
+	ids := make([]uint, len(refs))
+	for i, ref := range refs {
+		ids[i] = otherID(ref)
+	}
+	articles, err := s.articleRepo.GetByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]article.Article, len(articles))
+	for _, art := range articles {
+		byID[art.ID] = art
+	}
+
+	items := make([]map[string]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		art, ok := byID[otherID(ref)]
+		if !ok {
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"article_id":     art.ID,
+			"title":          art.Title,
+			"module_id":      art.ModuleID,
+			"reference_type": ref.ReferenceType,
+			"is_auto":        ref.IsAuto,
+			"created_at":     ref.CreatedAt,
+		})
+	}
+	return items, nil
+}
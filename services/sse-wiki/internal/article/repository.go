@@ -2,11 +2,18 @@ package article
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"terminal-terrace/sse-wiki/internal/database"
 	"terminal-terrace/sse-wiki/internal/model/article"
+	fileModel "terminal-terrace/sse-wiki/internal/model/file"
+	moduleModel "terminal-terrace/sse-wiki/internal/model/module"
 
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
@@ -29,11 +36,13 @@ func (r *ArticleRepository) GetByID(id uint) (*article.Article, error) {
 	return &art, err
 }
 
+// GetFavoriteByUserId 获取用户收藏的文章ID（回收站中的文章不返回）
 func (r *ArticleRepository) GetFavoriteByUserId(userID uint) ([]uint32, error) {
 	var articleIDs []uint32
 	err := r.db.Model(&article.Favorite{}).
-		Where("user_id = ?", userID).
-		Pluck("article_id", &articleIDs).Error
+		Joins("JOIN articles ON articles.id = favorites.article_id AND articles.deleted_at IS NULL").
+		Where("favorites.user_id = ?", userID).
+		Pluck("favorites.article_id", &articleIDs).Error
 	return articleIDs, err
 }
 
@@ -117,28 +126,1172 @@ func (r *ArticleRepository) GetUserRole(articleID uint, userID uint) *string {
 	return &collaborator.Role
 }
 
-// IncrementViewCount 增加阅读量（使用 Redis 去重，一个用户只记一次）
-func (r *ArticleRepository) IncrementViewCount(articleID uint, userID uint) error {
+const (
+	// viewDedupPeriod 同一访客在一个周期内对同一文章只计一次阅读
+	viewDedupPeriod = 30 * 24 * time.Hour
+	// pendingViewsKey 访客数可能变化、需要重新计数的文章（hash：文章ID:周期 -> 1）
+	pendingViewsKey = "article:views:pending"
+	// flushingViewsKey 正在写入数据库的批次（写入失败时保留，下一轮重试）
+	// 批次ID写入时同时冻结各文章的增量（d:文章ID:周期）和对应的访客数（c:文章ID:周期）
+	flushingViewsKey = "article:views:flushing"
+	// viewFlushLockKey 防止多个实例同时写入
+	viewFlushLockKey = "article:views:flush-lock"
+	viewFlushLockTTL = time.Minute
+	// viewFlushBatchField flushing 中记录批次ID的字段（与文章ID字段区分）
+	viewFlushBatchField = "batch"
+	// viewFlushBatchRetention 已写入批次记录的保留时间
+	viewFlushBatchRetention = 7 * 24 * time.Hour
+	// viewFlushBatchSize 每条 UPDATE 语句更新的文章数
+	viewFlushBatchSize = 500
+)
+
+// releaseLockScript 仅当锁仍由自己持有时删除（锁超时后可能已被其他实例获取）
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// randomToken 生成随机标识（锁持有者、批次ID）
+func randomToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// viewPeriod 返回时间所在的阅读去重周期
+func viewPeriod(t time.Time) int64 {
+	return t.Unix() / int64(viewDedupPeriod/time.Second)
+}
+
+// viewPeriodEnd 返回周期的结束时间（周期相关的 key 在结束后再保留一小时）
+func viewPeriodEnd(period int64) time.Time {
+	return time.Unix((period+1)*int64(viewDedupPeriod/time.Second), 0)
+}
+
+// uniqueViewersKey 文章在周期内的访客 HyperLogLog
+func uniqueViewersKey(articleID uint64, period int64) string {
+	return fmt.Sprintf("article:views:uv:%d:%d", articleID, period)
+}
+
+// flushedViewersKey 周期内各文章已写入 view_count 的访客数（hash：文章ID -> PFCOUNT）
+func flushedViewersKey(period int64) string {
+	return fmt.Sprintf("article:views:flushed:%d", period)
+}
+
+// parsePendingViewField 解析待计数字段（文章ID:周期）
+func parsePendingViewField(field string) (uint64, int64, bool) {
+	idPart, periodPart, found := strings.Cut(field, ":")
+	if !found {
+		return 0, 0, false
+	}
+	id, err1 := strconv.ParseUint(idPart, 10, 64)
+	period, err2 := strconv.ParseInt(periodPart, 10, 64)
+	return id, period, err1 == nil && err2 == nil
+}
+
+// IncrementViewCount 记录一次阅读
+// 访客使用 HyperLogLog 按周期去重；写入 view_count 的增量由 FlushPendingViews 按 PFCOUNT 与上次写入值之差计算
+func (r *ArticleRepository) IncrementViewCount(articleID uint, visitorID string) error {
+	if database.RedisDB == nil {
+		return nil
+	}
 	ctx := context.Background()
-	key := fmt.Sprintf("article:view:%d:%d", articleID, userID)
 
-	// 检查用户是否已浏览过
-	_, err := database.RedisDB.Get(ctx, key).Result()
-	if err == redis.Nil {
-		// 首次浏览，增加计数
-		// 使用原始 SQL，避免触发 GORM 的 updated_at 自动更新
-		updateErr := r.db.Exec("UPDATE articles SET view_count = view_count + 1 WHERE id = ?", articleID).Error
-		if updateErr != nil {
-			return updateErr
+	// 每次阅读都计入热度统计和每日统计（失败不影响阅读量）
+	r.recordTrendingView(ctx, articleID, visitorID)
+	r.recordDailyView(ctx, articleID, visitorID)
+
+	period := viewPeriod(time.Now())
+	key := uniqueViewersKey(uint64(articleID), period)
+	// PFADD 返回 1 只表示 HLL 寄存器发生了变化，不等于新访客（访客较多时大部分新访客不改变寄存器），
+	// 因此只用来标记文章需要重新计数：寄存器未变化时 PFCOUNT 也不会变化
+	changed, err := database.RedisDB.PFAdd(ctx, key, visitorID).Result()
+	if err != nil || changed == 0 {
+		return err
+	}
+
+	pipe := database.RedisDB.TxPipeline()
+	pipe.ExpireAt(ctx, key, viewPeriodEnd(period).Add(time.Hour))
+	pipe.HSet(ctx, pendingViewsKey, fmt.Sprintf("%d:%d", articleID, period), 1)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// PendingViewCount 获取文章尚未写入数据库的阅读量增量（当前周期的访客数减去已写入的部分）
+func (r *ArticleRepository) PendingViewCount(articleID uint) uint {
+	if database.RedisDB == nil {
+		return 0
+	}
+	ctx := context.Background()
+	period := viewPeriod(time.Now())
+	field := strconv.FormatUint(uint64(articleID), 10)
+
+	pipe := database.RedisDB.Pipeline()
+	count := pipe.PFCount(ctx, uniqueViewersKey(uint64(articleID), period))
+	flushed := pipe.HGet(ctx, flushedViewersKey(period), field)
+	pipe.Exec(ctx)
+
+	last, _ := flushed.Int64()
+	if pending := count.Val() - last; pending > 0 {
+		return uint(pending)
+	}
+	return 0
+}
+
+// FlushPendingViews 将缓冲的阅读量增量批量写入数据库，返回更新的文章数
+// 每个批次带有批次ID，与阅读量更新在同一事务中记录，重复写入同一批次时跳过
+func (r *ArticleRepository) FlushPendingViews() (int, error) {
+	if database.RedisDB == nil {
+		return 0, nil
+	}
+	ctx := context.Background()
+
+	token, err := randomToken()
+	if err != nil {
+		return 0, err
+	}
+	locked, err := database.RedisDB.SetNX(ctx, viewFlushLockKey, token, viewFlushLockTTL).Result()
+	if err != nil || !locked {
+		return 0, err
+	}
+	defer releaseLockScript.Run(ctx, database.RedisDB, []string{viewFlushLockKey}, token)
+
+	// 上一轮写入失败时 flushing 仍存在，先重试它，否则取走当前缓冲
+	retry, err := database.RedisDB.Exists(ctx, flushingViewsKey).Result()
+	if err != nil {
+		return 0, err
+	}
+	if retry == 0 {
+		renamed, err := database.RedisDB.RenameNX(ctx, pendingViewsKey, flushingViewsKey).Result()
+		if err != nil {
+			if strings.Contains(err.Error(), "no such key") {
+				return 0, nil
+			}
+			return 0, err
+		}
+		if !renamed {
+			return 0, nil
+		}
+	}
+
+	fields, err := database.RedisDB.HGetAll(ctx, flushingViewsKey).Result()
+	if err != nil {
+		return 0, err
+	}
+	// 首次处理该批次时按 PFCOUNT 计算增量，并与批次ID一起原子写入（重试时沿用，才能识别已写入的批次）
+	if fields[viewFlushBatchField] == "" {
+		if fields, err = r.freezeViewBatch(ctx, fields); err != nil {
+			return 0, err
+		}
+	}
+	batchID := fields[viewFlushBatchField]
+
+	deltaByID := make(map[uint64]int64)
+	flushedCounts := make(map[int64]map[string]int64) // 周期 -> 文章ID -> 访客数
+	for field, value := range fields {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		kind, rest, _ := strings.Cut(field, ":")
+		id, period, ok := parsePendingViewField(rest)
+		if !ok {
+			continue
+		}
+		switch kind {
+		case "d":
+			if n > 0 {
+				deltaByID[id] += n
+			}
+		case "c":
+			if flushedCounts[period] == nil {
+				flushedCounts[period] = make(map[string]int64)
+			}
+			flushedCounts[period][strconv.FormatUint(id, 10)] = n
+		}
+	}
+	ids := make([]uint64, 0, len(deltaByID))
+	deltas := make([]int64, 0, len(deltaByID))
+	for id, delta := range deltaByID {
+		ids = append(ids, id)
+		deltas = append(deltas, delta)
+	}
+
+	// 所有批次在同一事务中提交，失败时整体重试；批次ID已记录说明上一轮已提交，只需清理 Redis
+	applied := true
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Exec("INSERT INTO view_flush_batches (batch_id, created_at) VALUES (?, ?) ON CONFLICT (batch_id) DO NOTHING", batchID, now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			applied = false
+			return nil
+		}
+		if err := tx.Where("created_at < ?", now.Add(-viewFlushBatchRetention)).Delete(&article.ViewFlushBatch{}).Error; err != nil {
+			return err
+		}
+
+		for start := 0; start < len(ids); start += viewFlushBatchSize {
+			end := start + viewFlushBatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+			var sql strings.Builder
+			args := make([]interface{}, 0, (end-start)*2+1)
+			sql.WriteString("UPDATE articles SET view_count = view_count + CASE id")
+			for i := start; i < end; i++ {
+				sql.WriteString(" WHEN ? THEN ?")
+				args = append(args, ids[i], deltas[i])
+			}
+			sql.WriteString(" ELSE 0 END WHERE id IN ?")
+			args = append(args, ids[start:end])
+			// 使用原始 SQL，避免触发 GORM 的 updated_at 自动更新
+			if err := tx.Exec(sql.String(), args...).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	flushed := len(ids)
+	if !applied {
+		flushed = 0
+	}
+
+	// 记录已写入的访客数（绝对值，重复执行结果相同），再删除批次
+	pipe := database.RedisDB.Pipeline()
+	for period, counts := range flushedCounts {
+		values := make([]interface{}, 0, len(counts)*2)
+		for id, count := range counts {
+			values = append(values, id, count)
+		}
+		pipe.HSet(ctx, flushedViewersKey(period), values...)
+		pipe.ExpireAt(ctx, flushedViewersKey(period), viewPeriodEnd(period).Add(time.Hour))
+	}
+	pipe.Del(ctx, flushingViewsKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return flushed, err
+	}
+	return flushed, nil
+}
+
+// freezeViewBatch 计算批次中各文章的增量（当前 PFCOUNT 减去上次写入的访客数），与批次ID一起写入 flushing
+func (r *ArticleRepository) freezeViewBatch(ctx context.Context, fields map[string]string) (map[string]string, error) {
+	type pendingEntry struct {
+		field   string
+		count   *redis.IntCmd
+		flushed *redis.StringCmd
+	}
+	pipe := database.RedisDB.Pipeline()
+	var entries []pendingEntry
+	for field := range fields {
+		id, period, ok := parsePendingViewField(field)
+		if !ok {
+			continue
+		}
+		entries = append(entries, pendingEntry{
+			field:   field,
+			count:   pipe.PFCount(ctx, uniqueViewersKey(id, period)),
+			flushed: pipe.HGet(ctx, flushedViewersKey(period), strconv.FormatUint(id, 10)),
+		})
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	batchID, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	frozen := map[string]string{viewFlushBatchField: batchID}
+	values := []interface{}{viewFlushBatchField, batchID}
+	for _, e := range entries {
+		count := e.count.Val()
+		last, _ := e.flushed.Int64()
+		// 估算值个别情况下可能略有回落，已写入的部分不回退
+		if count < last {
+			count = last
+		}
+		delta := count - last
+		frozen["d:"+e.field] = strconv.FormatInt(delta, 10)
+		frozen["c:"+e.field] = strconv.FormatInt(count, 10)
+		values = append(values, "d:"+e.field, delta, "c:"+e.field, count)
+	}
+	if err := database.RedisDB.HSet(ctx, flushingViewsKey, values...).Err(); err != nil {
+		return nil, err
+	}
+	return frozen, nil
+}
+
+// GetRequiredApprovals 获取文章审核通过所需的审核人数
+// 文章未设置时沿模块树向上查找第一个设置了该值的模块，均未设置时为1
+func (r *ArticleRepository) GetRequiredApprovals(art *article.Article) int {
+	if art.RequiredApprovals != nil && *art.RequiredApprovals > 0 {
+		return *art.RequiredApprovals
+	}
+
+	visited := make(map[uint]bool)
+	for moduleID := &art.ModuleID; moduleID != nil && !visited[*moduleID]; {
+		visited[*moduleID] = true
+		var mod moduleModel.Module
+		if err := r.db.First(&mod, *moduleID).Error; err != nil {
+			break
+		}
+		if mod.RequiredApprovals != nil && *mod.RequiredApprovals > 0 {
+			return *mod.RequiredApprovals
+		}
+		moduleID = mod.ParentID
+	}
+	return 1
+}
+
+// ===== 回收站 =====
+
+// MoveToTrash 将文章移入回收站（软删除，保留所有关联数据以便恢复）
+func (r *ArticleRepository) MoveToTrash(articleID uint, deletedBy uint) error {
+	return r.db.Model(&article.Article{}).
+		Where("id = ?", articleID).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"deleted_by": deletedBy,
+		}).Error
+}
+
+// GetTrashedByID 获取回收站中的文章
+func (r *ArticleRepository) GetTrashedByID(id uint) (*article.Article, error) {
+	var art article.Article
+	err := r.db.Unscoped().Where("deleted_at IS NOT NULL").First(&art, id).Error
+	return &art, err
+}
+
+// ListTrash 分页获取指定模块中回收站的文章（按删除时间倒序）
+func (r *ArticleRepository) ListTrash(moduleIDs []uint, offset, limit int) ([]article.Article, int64, error) {
+	var articles []article.Article
+	var total int64
+
+	query := r.db.Unscoped().Model(&article.Article{}).
+		Where("deleted_at IS NOT NULL AND module_id IN ?", moduleIDs)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("deleted_at DESC").Offset(offset).Limit(limit).Find(&articles).Error
+	return articles, total, err
+}
+
+// Restore 从回收站恢复文章
+func (r *ArticleRepository) Restore(articleID uint) error {
+	return r.db.Unscoped().Model(&article.Article{}).
+		Where("id = ?", articleID).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at": nil,
+			"deleted_by": nil,
+		}).Error
+}
+
+// ListExpiredTrash 获取删除时间早于 before 的回收站文章ID
+func (r *ArticleRepository) ListExpiredTrash(before time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Unscoped().Model(&article.Article{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("deleted_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// GetModuleSubtreeIDs 获取模块及其所有子孙模块的ID
+func (r *ArticleRepository) GetModuleSubtreeIDs(moduleID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Raw(`
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM modules WHERE id = ?
+			UNION ALL
+			SELECT m.id FROM modules m
+			INNER JOIN subtree s ON m.parent_id = s.id
+		)
+		SELECT id FROM subtree
+	`, moduleID).Scan(&ids).Error
+	return ids, err
+}
+
+// ===== 文章移动 =====
+
+// ModuleExists 检查模块是否存在
+func (r *ArticleRepository) ModuleExists(moduleID uint) bool {
+	var count int64
+	r.db.Model(&moduleModel.Module{}).Where("id = ?", moduleID).Count(&count)
+	return count > 0
+}
+
+// GetModule 获取模块
+func (r *ArticleRepository) GetModule(moduleID uint) (*moduleModel.Module, error) {
+	var mod moduleModel.Module
+	err := r.db.First(&mod, moduleID).Error
+	return &mod, err
+}
+
+// MoveToModule 在同一事务中更新文章所属模块并记录移动
+func (r *ArticleRepository) MoveToModule(art *article.Article, toModuleID uint, movedBy uint) (*article.ArticleMove, error) {
+	move := &article.ArticleMove{
+		ArticleID:    art.ID,
+		FromModuleID: art.ModuleID,
+		ToModuleID:   toModuleID,
+		MovedBy:      movedBy,
+		CreatedAt:    time.Now(),
+	}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(art).Updates(map[string]interface{}{
+			"module_id":  toModuleID,
+			"updated_at": move.CreatedAt,
+		}).Error; err != nil {
+			return err
 		}
+		return tx.Create(move).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	art.ModuleID = toModuleID
+	art.UpdatedAt = move.CreatedAt
+	return move, nil
+}
+
+// ListMoves 获取文章的移动记录（按时间升序）
+func (r *ArticleRepository) ListMoves(articleID uint) ([]article.ArticleMove, error) {
+	var moves []article.ArticleMove
+	err := r.db.Where("article_id = ?", articleID).Order("created_at ASC, id ASC").Find(&moves).Error
+	return moves, err
+}
+
+// HasMovedFrom 检查文章是否曾位于指定模块
+func (r *ArticleRepository) HasMovedFrom(articleID uint, moduleID uint) bool {
+	var count int64
+	r.db.Model(&article.ArticleMove{}).
+		Where("article_id = ? AND from_module_id = ?", articleID, moduleID).
+		Count(&count)
+	return count > 0
+}
+
+// ===== 文章引用 =====
+
+// GetByIDs 批量获取文章（不含回收站中的文章）
+func (r *ArticleRepository) GetByIDs(ids []uint) ([]article.Article, error) {
+	var articles []article.Article
+	if len(ids) == 0 {
+		return articles, nil
+	}
+	err := r.db.Where("id IN ?", ids).Find(&articles).Error
+	return articles, err
+}
+
+// GetReference 获取两篇文章之间的引用关系
+func (r *ArticleRepository) GetReference(fromArticleID uint, toArticleID uint) (*article.ArticleReference, error) {
+	var ref article.ArticleReference
+	err := r.db.Where("from_article_id = ? AND to_article_id = ?", fromArticleID, toArticleID).First(&ref).Error
+	return &ref, err
+}
+
+// CreateReference 创建引用关系
+func (r *ArticleRepository) CreateReference(ref *article.ArticleReference) error {
+	return r.db.Create(ref).Error
+}
+
+// UpdateReference 更新引用关系的类型和来源
+func (r *ArticleRepository) UpdateReference(ref *article.ArticleReference) error {
+	return r.db.Model(&article.ArticleReference{}).
+		Where("from_article_id = ? AND to_article_id = ?", ref.FromArticleID, ref.ToArticleID).
+		Updates(map[string]interface{}{
+			"reference_type": ref.ReferenceType,
+			"is_auto":        ref.IsAuto,
+		}).Error
+}
+
+// FindByTitle 按标题精确查找文章（不含回收站中的文章，按ID升序）
+func (r *ArticleRepository) FindByTitle(title string) ([]article.Article, error) {
+	var articles []article.Article
+	err := r.db.Where("title = ?", title).Order("id ASC").Find(&articles).Error
+	return articles, err
+}
+
+// SyncAutoReferences 将文章自动生成的引用同步为 targetIDs
+// 删除不再出现的自动引用，为新出现的链接创建 related 引用；已存在的手动引用保持不变
+func (r *ArticleRepository) SyncAutoReferences(fromArticleID uint, targetIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		remove := tx.Where("from_article_id = ? AND is_auto = ?", fromArticleID, true)
+		if len(targetIDs) > 0 {
+			remove = remove.Where("to_article_id NOT IN ?", targetIDs)
+		}
+		if err := remove.Delete(&article.ArticleReference{}).Error; err != nil {
+			return err
+		}
+		if len(targetIDs) == 0 {
+			return nil
+		}
+
+		var existing []uint
+		if err := tx.Model(&article.ArticleReference{}).
+			Where("from_article_id = ? AND to_article_id IN ?", fromArticleID, targetIDs).
+			Pluck("to_article_id", &existing).Error; err != nil {
+			return err
+		}
+		exists := make(map[uint]bool, len(existing))
+		for _, id := range existing {
+			exists[id] = true
+		}
+
+		now := time.Now()
+		for _, id := range targetIDs {
+			if exists[id] {
+				continue
+			}
+			if err := tx.Create(&article.ArticleReference{
+				FromArticleID: fromArticleID,
+				ToArticleID:   id,
+				ReferenceType: "related",
+				IsAuto:        true,
+				CreatedAt:     now,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteReference 删除引用关系，返回是否存在该引用
+func (r *ArticleRepository) DeleteReference(fromArticleID uint, toArticleID uint) (bool, error) {
+	result := r.db.Where("from_article_id = ? AND to_article_id = ?", fromArticleID, toArticleID).
+		Delete(&article.ArticleReference{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListOutgoingReferences 获取文章引用的其他文章（refType 为空时返回所有类型，忽略回收站中的文章）
+func (r *ArticleRepository) ListOutgoingReferences(articleID uint, refType string) ([]article.ArticleReference, error) {
+	var refs []article.ArticleReference
+	query := r.db.Where("from_article_id = ?", articleID).
+		Where("to_article_id IN (?)", r.db.Model(&article.Article{}).Select("id"))
+	if refType != "" {
+		query = query.Where("reference_type = ?", refType)
+	}
+	err := query.Order("created_at ASC").Find(&refs).Error
+	return refs, err
+}
+
+// ListBacklinks 获取引用了该文章的其他文章（refType 为空时返回所有类型，忽略回收站中的文章）
+func (r *ArticleRepository) ListBacklinks(articleID uint, refType string) ([]article.ArticleReference, error) {
+	var refs []article.ArticleReference
+	query := r.db.Where("to_article_id = ?", articleID).
+		Where("from_article_id IN (?)", r.db.Model(&article.Article{}).Select("id"))
+	if refType != "" {
+		query = query.Where("reference_type = ?", refType)
+	}
+	err := query.Order("created_at ASC").Find(&refs).Error
+	return refs, err
+}
+
+// ===== 失效链接检查 =====
+
+// ListByModuleIDs 获取多个模块下的全部文章（不含回收站中的文章，按ID升序）
+func (r *ArticleRepository) ListByModuleIDs(moduleIDs []uint) ([]article.Article, error) {
+	var articles []article.Article
+	err := r.db.Where("module_id IN ?", moduleIDs).Order("id ASC").Find(&articles).Error
+	return articles, err
+}
+
+// GetByIDsWithTrash 批量获取文章（包含回收站中的文章）
+func (r *ArticleRepository) GetByIDsWithTrash(ids []uint) ([]article.Article, error) {
+	var articles []article.Article
+	if len(ids) == 0 {
+		return articles, nil
+	}
+	err := r.db.Unscoped().Where("id IN ?", ids).Find(&articles).Error
+	return articles, err
+}
+
+// TitleStatuses 批量查询标题对应的文章（含回收站中的文章）
+// 返回 titles 中存在的标题，值为 true 表示至少有一篇未删除的文章，false 表示只存在于回收站中
+func (r *ArticleRepository) TitleStatuses(titles []string) (map[string]bool, error) {
+	statuses := make(map[string]bool)
+	if len(titles) == 0 {
+		return statuses, nil
+	}
+	var rows []struct {
+		Title string
+		Live  bool
+	}
+	err := r.db.Unscoped().Model(&article.Article{}).
+		Select("title, BOOL_OR(deleted_at IS NULL) AS live").
+		Where("title IN ?", titles).
+		Group("title").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		statuses[row.Title] = row.Live
+	}
+	return statuses, nil
+}
+
+// ExistingFileIDs 返回 ids 中存在的文件ID
+func (r *ArticleRepository) ExistingFileIDs(ids []uint) (map[uint]bool, error) {
+	existing := make(map[uint]bool)
+	if len(ids) == 0 {
+		return existing, nil
+	}
+	var found []uint
+	if err := r.db.Model(&fileModel.File{}).Where("id IN ?", ids).Pluck("id", &found).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range found {
+		existing[id] = true
+	}
+	return existing, nil
+}
 
-		// 标记已浏览（30天过期）
-		database.RedisDB.Set(ctx, key, "1", 30*24*time.Hour)
+// ExistingFilePaths 返回 paths 中存在的文件存储路径
+func (r *ArticleRepository) ExistingFilePaths(paths []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(paths) == 0 {
+		return existing, nil
+	}
+	var found []string
+	if err := r.db.Model(&fileModel.File{}).Where("file_path IN ?", paths).Pluck("file_path", &found).Error; err != nil {
+		return nil, err
+	}
+	for _, p := range found {
+		existing[p] = true
 	}
+	return existing, nil
+}
+
+// ListMissingVersionFiles 获取版本关联但文件记录已不存在的附件
+func (r *ArticleRepository) ListMissingVersionFiles(versionIDs []uint) ([]fileModel.ArticleVersionFile, error) {
+	var files []fileModel.ArticleVersionFile
+	if len(versionIDs) == 0 {
+		return files, nil
+	}
+	err := r.db.Where("version_id IN ?", versionIDs).
+		Where("file_id NOT IN (?)", r.db.Model(&fileModel.File{}).Select("id")).
+		Order("version_id ASC, position ASC").
+		Find(&files).Error
+	return files, err
+}
+
+// ===== 全文搜索 =====
+
+// SearchHit 搜索命中的文章及相关度
+type SearchHit struct {
+	ArticleID uint
+	// 建立索引时的版本，用于生成摘要
+	VersionID uint
+	Rank      float64
+}
 
+// UpsertSearchIndex 写入或更新文章的搜索索引
+// titleTokens/contentTokens 为预先分词、以空格分隔的文本，标题权重高于正文
+func (r *ArticleRepository) UpsertSearchIndex(articleID, versionID uint, title, titleTokens, contentTokens string) error {
+	return r.db.Exec(`
+		INSERT INTO article_search_index (article_id, version_id, title, search_vector, updated_at)
+		VALUES (?, ?, ?, setweight(to_tsvector('simple', ?), 'A') || setweight(to_tsvector('simple', ?), 'B'), ?)
+		ON CONFLICT (article_id) DO UPDATE SET
+			version_id = EXCLUDED.version_id,
+			title = EXCLUDED.title,
+			search_vector = EXCLUDED.search_vector,
+			updated_at = EXCLUDED.updated_at
+	`, articleID, versionID, title, titleTokens, contentTokens, time.Now()).Error
+}
+
+// ListStaleSearchIndex 获取索引缺失或已过期（版本、标题不一致）的文章
+func (r *ArticleRepository) ListStaleSearchIndex(limit int) ([]article.Article, error) {
+	var articles []article.Article
+	err := r.db.Model(&article.Article{}).
+		Joins("LEFT JOIN article_search_index idx ON idx.article_id = articles.id").
+		Where("articles.current_version_id IS NOT NULL").
+		Where("idx.article_id IS NULL OR idx.version_id <> articles.current_version_id OR idx.title <> articles.title").
+		Order("articles.id ASC").
+		Limit(limit).
+		Find(&articles).Error
+	return articles, err
+}
+
+// Search 全文搜索（按相关度倒序，不含回收站中的文章）
+// queryTokens 为预先分词的关键词，所有词都需命中；moduleIDs 为空时不限模块；tagNames 非空时文章需包含全部标签
+func (r *ArticleRepository) Search(queryTokens string, moduleIDs []uint, tagNames []string, offset, limit int) ([]SearchHit, int64, error) {
+	var hits []SearchHit
+	var total int64
+
+	query := r.db.Table("article_search_index AS idx").
+		Joins("JOIN articles ON articles.id = idx.article_id AND articles.deleted_at IS NULL").
+		Where("idx.search_vector @@ plainto_tsquery('simple', ?)", queryTokens)
+	if len(moduleIDs) > 0 {
+		query = query.Where("articles.module_id IN ?", moduleIDs)
+	}
+	if len(tagNames) > 0 {
+		query = query.Where("articles.id IN (?)", r.articleIDsWithTags(tagNames, true))
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Select("idx.article_id, idx.version_id, ts_rank_cd(idx.search_vector, plainto_tsquery('simple', ?)) AS rank", queryTokens).
+		Order("rank DESC, articles.updated_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Scan(&hits).Error
+	return hits, total, err
+}
+
+// ===== 热门文章 =====
+
+const (
+	// trendingBucketTTL 每小时热度计数的保留时间（覆盖最长的统计窗口）
+	trendingBucketTTL = 32 * 24 * time.Hour
+	// trendingDedupTTL 同一用户对同一文章的阅读每小时只计一次
+	trendingDedupTTL = time.Hour
+	// trendingRankingTTL 排行结果缓存时间
+	trendingRankingTTL = 5 * time.Minute
+)
+
+// TrendingScore 文章在统计窗口内的热度
+type TrendingScore struct {
+	ArticleID uint
+	Score     float64 // 按时间衰减后的热度
+	Views     int64   // 窗口内的阅读次数（未衰减）
+}
+
+// trendingBucketKey 指定时间所在小时的热度计数 key（有序集合，member 为文章ID）
+func trendingBucketKey(t time.Time) string {
+	return "article:trending:hour:" + t.UTC().Format("2006010215")
+}
+
+// recordTrendingView 将一次阅读计入当前小时的热度计数（同一访客每小时计一次）
+func (r *ArticleRepository) recordTrendingView(ctx context.Context, articleID uint, visitorID string) {
+	if database.RedisDB == nil {
+		return
+	}
+	now := time.Now()
+	dedupKey := fmt.Sprintf("article:trending:seen:%d:%s:%s", articleID, visitorID, now.UTC().Format("2006010215"))
+	if first, err := database.RedisDB.SetNX(ctx, dedupKey, "1", trendingDedupTTL).Result(); err != nil || !first {
+		return
+	}
+
+	key := trendingBucketKey(now)
+	pipe := database.RedisDB.TxPipeline()
+	pipe.ZIncrBy(ctx, key, 1, strconv.FormatUint(uint64(articleID), 10))
+	pipe.Expire(ctx, key, trendingBucketTTL)
+	pipe.Exec(ctx)
+}
+
+// ListTrending 获取统计窗口内按热度倒序的文章（可能包含已删除的文章，由调用方过滤）
+// 窗口内每小时的阅读数按 2^(-距今小时数/halfLifeHours) 衰减后求和，排行结果按 window 缓存
+func (r *ArticleRepository) ListTrending(window string, hours int, halfLifeHours float64, offset, limit int) ([]TrendingScore, error) {
+	if database.RedisDB == nil {
+		return nil, nil
+	}
+	ctx := context.Background()
+	scoreKey := "article:trending:rank:" + window
+	viewsKey := "article:trending:views:" + window
+
+	cached, err := database.RedisDB.Exists(ctx, scoreKey, viewsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if cached < 2 {
+		now := time.Now()
+		keys := make([]string, hours)
+		decay := make([]float64, hours)
+		ones := make([]float64, hours)
+		for i := 0; i < hours; i++ {
+			keys[i] = trendingBucketKey(now.Add(-time.Duration(i) * time.Hour))
+			decay[i] = math.Pow(2, -float64(i)/halfLifeHours)
+			ones[i] = 1
+		}
+		pipe := database.RedisDB.TxPipeline()
+		pipe.ZUnionStore(ctx, scoreKey, &redis.ZStore{Keys: keys, Weights: decay})
+		pipe.ZUnionStore(ctx, viewsKey, &redis.ZStore{Keys: keys, Weights: ones})
+		pipe.Expire(ctx, scoreKey, trendingRankingTTL)
+		pipe.Expire(ctx, viewsKey, trendingRankingTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := database.RedisDB.ZRevRangeWithScores(ctx, scoreKey, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	members := make([]string, len(entries))
+	for i, entry := range entries {
+		members[i], _ = entry.Member.(string)
+	}
+	views, err := database.RedisDB.ZMScore(ctx, viewsKey, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]TrendingScore, 0, len(entries))
+	for i, entry := range entries {
+		id, err := strconv.ParseUint(members[i], 10, 64)
+		if err != nil {
+			continue
+		}
+		scores = append(scores, TrendingScore{
+			ArticleID: uint(id),
+			Score:     entry.Score,
+			Views:     int64(views[i]),
+		})
+	}
+	return scores, nil
+}
+
+// ===== 阅读统计 =====
+
+// dailyStatsTTL 每日阅读计数在 Redis 中的保留时间（写入数据库后即可过期）
+const dailyStatsTTL = 3 * 24 * time.Hour
+
+// dailyStatsDate 统计日期（本地日期，以 UTC 零点表示，与 date 列一致）
+func dailyStatsDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// dailyViewsKey 某天各文章阅读次数的 key（hash：文章ID -> 次数）
+func dailyViewsKey(date time.Time) string {
+	return "article:stats:views:" + date.Format("20060102")
+}
+
+// dailyVisitorsKey 某天某文章独立访客的 HyperLogLog key
+func dailyVisitorsKey(date time.Time, articleID string) string {
+	return "article:stats:uv:" + date.Format("20060102") + ":" + articleID
+}
+
+// recordDailyView 将一次阅读计入当天的阅读次数和独立访客
+func (r *ArticleRepository) recordDailyView(ctx context.Context, articleID uint, visitorID string) {
+	if database.RedisDB == nil {
+		return
+	}
+	date := dailyStatsDate(time.Now())
+	field := strconv.FormatUint(uint64(articleID), 10)
+	viewsKey := dailyViewsKey(date)
+	visitorsKey := dailyVisitorsKey(date, field)
+
+	pipe := database.RedisDB.TxPipeline()
+	pipe.HIncrBy(ctx, viewsKey, field, 1)
+	pipe.Expire(ctx, viewsKey, dailyStatsTTL)
+	pipe.PFAdd(ctx, visitorsKey, visitorID)
+	pipe.Expire(ctx, visitorsKey, dailyStatsTTL)
+	pipe.Exec(ctx)
+}
+
+// FlushDailyStats 将 Redis 中昨天和今天的阅读统计写入 article_daily_stats
+// 写入的是当天累计值而非增量，重复执行不会重复累加
+func (r *ArticleRepository) FlushDailyStats() error {
+	if database.RedisDB == nil {
+		return nil
+	}
+	ctx := context.Background()
+	today := dailyStatsDate(time.Now())
+
+	for _, date := range []time.Time{today.AddDate(0, 0, -1), today} {
+		views, err := database.RedisDB.HGetAll(ctx, dailyViewsKey(date)).Result()
+		if err != nil {
+			return err
+		}
+		if len(views) == 0 {
+			continue
+		}
+
+		fields := make([]string, 0, len(views))
+		ids := make([]uint64, 0, len(views))
+		for field := range views {
+			id, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				continue
+			}
+			fields = append(fields, field)
+			ids = append(ids, id)
+		}
+
+		// 只写入仍存在的文章（含回收站中的文章）
+		var existing []uint64
+		if err := r.db.Unscoped().Model(&article.Article{}).Where("id IN ?", ids).Pluck("id", &existing).Error; err != nil {
+			return err
+		}
+		exists := make(map[uint64]bool, len(existing))
+		for _, id := range existing {
+			exists[id] = true
+		}
+
+		pipe := database.RedisDB.Pipeline()
+		counts := make([]*redis.IntCmd, len(fields))
+		for i, field := range fields {
+			counts[i] = pipe.PFCount(ctx, dailyVisitorsKey(date, field))
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var values []string
+		var args []interface{}
+		for i, field := range fields {
+			if !exists[ids[i]] {
+				continue
+			}
+			viewCount, _ := strconv.ParseInt(views[field], 10, 64)
+			values = append(values, "(?, ?, ?, ?, ?)")
+			args = append(args, ids[i], date, viewCount, counts[i].Val(), now)
+		}
+
+		for start := 0; start < len(values); start += viewFlushBatchSize {
+			end := start + viewFlushBatchSize
+			if end > len(values) {
+				end = len(values)
+			}
+			err := r.db.Exec(`
+				INSERT INTO article_daily_stats (article_id, date, views, unique_visitors, updated_at)
+				VALUES `+strings.Join(values[start:end], ", ")+`
+				ON CONFLICT (article_id, date) DO UPDATE SET
+					views = EXCLUDED.views,
+					unique_visitors = EXCLUDED.unique_visitors,
+					updated_at = EXCLUDED.updated_at
+			`, args[start*5:end*5]...).Error
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// ListDailyStats 获取文章在日期范围内（含首尾）的每日阅读统计
+func (r *ArticleRepository) ListDailyStats(articleID uint, from, to time.Time) ([]article.ArticleDailyStat, error) {
+	var stats []article.ArticleDailyStat
+	err := r.db.Where("article_id = ? AND date BETWEEN ? AND ?", articleID, from, to).
+		Order("date ASC").
+		Find(&stats).Error
+	return stats, err
+}
+
+// ListPublishedVersionsBetween 获取文章在时间范围内发布的版本（不含正文）
+func (r *ArticleRepository) ListPublishedVersionsBetween(articleID uint, from, to time.Time) ([]article.ArticleVersion, error) {
+	var versions []article.ArticleVersion
+	err := r.db.Select("id", "article_id", "version_number", "commit_message", "author_id", "status", "created_at").
+		Where("article_id = ? AND status = ? AND created_at >= ? AND created_at < ?", articleID, "published", from, to).
+		Order("version_number ASC").
+		Find(&versions).Error
+	return versions, err
+}
+
+// ListSubmissionTimes 获取文章在时间范围内创建的提交的创建时间
+func (r *ArticleRepository) ListSubmissionTimes(articleID uint, from, to time.Time) ([]time.Time, error) {
+	var times []time.Time
+	err := r.db.Model(&article.ReviewSubmission{}).
+		Where("article_id = ? AND created_at >= ? AND created_at < ?", articleID, from, to).
+		Pluck("created_at", &times).Error
+	return times, err
+}
+
+// ListCommentTimes 获取文章讨论区在时间范围内发表的评论（不含已删除评论）的创建时间
+func (r *ArticleRepository) ListCommentTimes(articleID uint, from, to time.Time) ([]time.Time, error) {
+	var times []time.Time
+	err := r.db.Table("discussion_comments").
+		Joins("JOIN discussions ON discussions.id = discussion_comments.discussion_id AND discussions.deleted_at IS NULL").
+		Where("discussions.article_id = ? AND discussion_comments.is_deleted = ?", articleID, false).
+		Where("discussion_comments.created_at >= ? AND discussion_comments.created_at < ?", from, to).
+		Pluck("discussion_comments.created_at", &times).Error
+	return times, err
+}
+
+// ===== 动态 =====
+
+// ActivityEvent 动态事件
+type ActivityEvent struct {
+	EventType    string
+	OccurredAt   time.Time
+	ActorID      uint // 操作人（module_updated 无法确定操作人，为 0）
+	ArticleID    uint // 模块事件为 0
+	ModuleID     uint
+	RefID        uint   // 事件对应的记录ID：文章/版本/提交/评论/移动记录/模块
+	Detail       string // 提交信息、审核结果、评论摘要、移动前模块ID或模块名
+	ArticleTitle string
+	ModuleName   string
+	ActorName    string
+}
+
+// activityEventSources 各类动态事件的查询，列依次为（列名由 ListActivity 统一指定）
+// event_type, occurred_at, actor_id, article_id, module_id, ref_id, detail
+// 文章相关事件只包含未删除的文章
+var activityEventSources = map[string][]string{
+	"article_created": {`
+		SELECT 'article_created', a.created_at, a.created_by, a.id, a.module_id, a.id, ''
+		FROM articles a WHERE a.deleted_at IS NULL`},
+	// 审核通过的版本以审核时间为发布时间；初始版本由 article_created 表示
+	"version_published": {`
+		SELECT 'version_published', COALESCE(s.reviewed_at, v.created_at), v.author_id,
+			a.id, a.module_id, v.id, v.commit_message
+		FROM article_versions v
+		JOIN articles a ON a.id = v.article_id AND a.deleted_at IS NULL
+		LEFT JOIN review_submissions s ON s.proposed_version_id = v.id AND s.status = 'merged'
+		WHERE v.status = 'published' AND v.version_number > 1`},
+	"submission_created": {`
+		SELECT 'submission_created', s.created_at, s.submitted_by, a.id, a.module_id, s.id, s.status
+		FROM review_submissions s
+		JOIN articles a ON a.id = s.article_id AND a.deleted_at IS NULL`},
+	// 审核决定：合并、驳回、要求修改（提交推送新修订后，之前的要求修改记录在 submission_revisions 中）
+	"review_decision": {`
+		SELECT 'review_decision', s.reviewed_at, s.reviewed_by, a.id, a.module_id, s.id, s.status
+		FROM review_submissions s
+		JOIN articles a ON a.id = s.article_id AND a.deleted_at IS NULL
+		WHERE s.reviewed_at IS NOT NULL AND s.reviewed_by IS NOT NULL
+			AND s.status IN ('merged', 'rejected', 'changes_requested')`, `
+		SELECT 'review_decision', r.reviewed_at, r.reviewed_by, a.id, a.module_id, r.submission_id, 'changes_requested'
+		FROM submission_revisions r
+		JOIN review_submissions s ON s.id = r.submission_id
+		JOIN articles a ON a.id = s.article_id AND a.deleted_at IS NULL
+		WHERE r.reviewed_at IS NOT NULL AND r.reviewed_by IS NOT NULL`},
+	"comment_created": {`
+		SELECT 'comment_created', c.created_at, c.created_by, a.id, a.module_id, c.id, LEFT(c.content, 100)
+		FROM discussion_comments c
+		JOIN discussions d ON d.id = c.discussion_id AND d.deleted_at IS NULL
+		JOIN articles a ON a.id = d.article_id AND a.deleted_at IS NULL
+		WHERE c.is_deleted = false`},
+	"article_moved": {`
+		SELECT 'article_moved', mv.created_at, mv.moved_by, a.id, mv.to_module_id, mv.id, CAST(mv.from_module_id AS TEXT)
+		FROM article_moves mv
+		JOIN articles a ON a.id = mv.article_id AND a.deleted_at IS NULL`},
+	"module_created": {`
+		SELECT 'module_created', m.created_at, m.owner_id, 0, m.id, m.id, m.module_name
+		FROM modules m`},
+	// 模块只记录最后修改时间，因此每个模块只有最近一次修改
+	"module_updated": {`
+		SELECT 'module_updated', m.updated_at, 0, 0, m.id, m.id, m.module_name
+		FROM modules m WHERE m.updated_at > m.created_at + INTERVAL '1 second'`},
+}
+
+// ListActivity 按时间倒序分页获取动态事件
+// eventTypes 为要包含的事件类型（需为 activityEventSources 中的类型）；moduleIDs 为空时不限模块；actorID 为 0 时不限操作人
+func (r *ArticleRepository) ListActivity(eventTypes []string, moduleIDs []uint, actorID uint, offset, limit int) ([]ActivityEvent, int64, error) {
+	var sources []string
+	for _, eventType := range eventTypes {
+		sources = append(sources, activityEventSources[eventType]...)
+	}
+	if len(sources) == 0 {
+		return nil, 0, nil
+	}
+	union := "(" + strings.Join(sources, " UNION ALL ") +
+		") e (event_type, occurred_at, actor_id, article_id, module_id, ref_id, detail)"
+
+	where := "WHERE TRUE"
+	var args []interface{}
+	if len(moduleIDs) > 0 {
+		where += " AND e.module_id IN ?"
+		args = append(args, moduleIDs)
+	}
+	if actorID != 0 {
+		where += " AND e.actor_id = ?"
+		args = append(args, actorID)
+	}
+
+	var total int64
+	if err := r.db.Raw("SELECT COUNT(*) FROM "+union+" "+where, args...).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var events []ActivityEvent
+	err := r.db.Raw(`
+		SELECT e.*, COALESCE(a.title, '') AS article_title, COALESCE(m.module_name, '') AS module_name,
+			COALESCE(u.username, '') AS actor_name
+		FROM `+union+`
+		LEFT JOIN articles a ON a.id = e.article_id
+		LEFT JOIN modules m ON m.id = e.module_id
+		LEFT JOIN auth_users u ON u.id = e.actor_id
+		`+where+`
+		ORDER BY e.occurred_at DESC, e.event_type ASC, e.ref_id DESC
+		LIMIT ? OFFSET ?
+	`, append(args, limit, offset)...).Scan(&events).Error
+	return events, total, err
+}
+
+// ===== 订阅源 =====
+
+// FeedEntry 订阅源中的一个已发布版本
+type FeedEntry struct {
+	VersionID     uint
+	VersionNumber int
+	CommitMessage string
+	AuthorID      uint
+	AuthorName    string
+	ArticleID     uint
+	ArticleTitle  string
+	ModuleID      uint
+	PublishedAt   time.Time
+}
+
+// ListFeedEntries 按发布时间倒序获取已发布的版本（不含回收站中的文章；审核通过的版本以审核时间为发布时间）
+// moduleIDs 非空时限定模块，articleID 非 0 时限定文章
+func (r *ArticleRepository) ListFeedEntries(moduleIDs []uint, articleID uint, limit int) ([]FeedEntry, error) {
+	query := r.db.Table("article_versions v").
+		Select(`v.id AS version_id, v.version_number, v.commit_message, v.author_id,
+			COALESCE(u.username, '') AS author_name, a.id AS article_id, a.title AS article_title,
+			a.module_id, COALESCE(s.reviewed_at, v.created_at) AS published_at`).
+		Joins("JOIN articles a ON a.id = v.article_id AND a.deleted_at IS NULL").
+		Joins("LEFT JOIN review_submissions s ON s.proposed_version_id = v.id AND s.status = 'merged'").
+		Joins("LEFT JOIN auth_users u ON u.id = v.author_id").
+		Where("v.status = ?", "published")
+	if len(moduleIDs) > 0 {
+		query = query.Where("a.module_id IN ?", moduleIDs)
+	}
+	if articleID != 0 {
+		query = query.Where("a.id = ?", articleID)
+	}
+
+	var entries []FeedEntry
+	err := query.Order("published_at DESC, v.id DESC").Limit(limit).Scan(&entries).Error
+	return entries, err
+}
+
+// ===== 个人草稿 =====
+
+// SaveDraft 保存草稿（同一用户同一文章只保留一份）
+func (r *ArticleRepository) SaveDraft(draft *article.ArticleDraft) error {
+	return r.db.Save(draft).Error
+}
+
+// GetDraft 获取用户在文章下的草稿
+func (r *ArticleRepository) GetDraft(articleID uint, userID uint) (*article.ArticleDraft, error) {
+	var draft article.ArticleDraft
+	err := r.db.Where("article_id = ? AND user_id = ?", articleID, userID).First(&draft).Error
+	return &draft, err
+}
+
+// DeleteDraft 删除用户在文章下的草稿，返回是否删除了记录
+func (r *ArticleRepository) DeleteDraft(articleID uint, userID uint) (bool, error) {
+	result := r.db.Where("article_id = ? AND user_id = ?", articleID, userID).Delete(&article.ArticleDraft{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListDraftsByUser 获取用户的所有草稿（按最近修改排序，已删除文章的草稿不返回）
+func (r *ArticleRepository) ListDraftsByUser(userID uint) ([]article.ArticleDraft, error) {
+	var drafts []article.ArticleDraft
+	err := r.db.Where("user_id = ?", userID).
+		Where("article_id IN (?)", r.db.Model(&article.Article{}).Select("id")).
+		Order("updated_at DESC").
+		Find(&drafts).Error
+	return drafts, err
+}
+
 // AddCollaborator 添加协作者
 func (r *ArticleRepository) AddCollaborator(articleID uint, userID uint, role string) error {
 	collaborator := &article.ArticleCollaborator{
@@ -163,6 +1316,43 @@ func (r *ArticleRepository) GetCollaborators(articleID uint) ([]article.ArticleC
 	return collaborators, err
 }
 
+// ListByTags 按标签分页获取文章列表
+// matchAll 为 true 时文章需包含所有标签，否则包含任一标签即可；moduleIDs 为空时不限模块
+// sortBy 为 "view_count" 时按阅读量倒序，否则按更新时间倒序
+func (r *ArticleRepository) ListByTags(tagNames []string, matchAll bool, moduleIDs []uint, sortBy string, offset, limit int) ([]article.Article, int64, error) {
+	var articles []article.Article
+	var total int64
+
+	query := r.db.Model(&article.Article{}).Where("id IN (?)", r.articleIDsWithTags(tagNames, matchAll))
+	if len(moduleIDs) > 0 {
+		query = query.Where("module_id IN ?", moduleIDs)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if sortBy == "view_count" {
+		query = query.Order("view_count DESC, id DESC")
+	} else {
+		query = query.Order("updated_at DESC, id DESC")
+	}
+	err := query.Offset(offset).Limit(limit).Find(&articles).Error
+	return articles, total, err
+}
+
+// articleIDsWithTags 包含指定标签的文章ID子查询（matchAll 为 true 时需包含全部标签）
+func (r *ArticleRepository) articleIDsWithTags(tagNames []string, matchAll bool) *gorm.DB {
+	matched := r.db.Table("article_tags").
+		Select("article_tags.article_id").
+		Joins("JOIN tags ON tags.id = article_tags.tag_id").
+		Where("tags.name IN ?", tagNames).
+		Group("article_tags.article_id")
+	if matchAll {
+		matched = matched.Having("COUNT(DISTINCT tags.id) = ?", len(tagNames))
+	}
+	return matched
+}
+
 // ListByModuleID 根据模块ID获取文章列表
 func (r *ArticleRepository) ListByModuleID(moduleID uint, offset, limit int) ([]article.Article, int64, error) {
 	var articles []article.Article
@@ -189,20 +1379,68 @@ func NewVersionRepository(db *gorm.DB) *VersionRepository {
 	return &VersionRepository{db: db}
 }
 
+// Create 创建版本，内容以快照或增量方式压缩存储
 func (r *VersionRepository) Create(version *article.ArticleVersion) error {
-	return r.db.Create(version).Error
+	content := version.Content
+	if err := r.encodeContent(version); err != nil {
+		version.Content = content
+		return err
+	}
+	err := r.db.Create(version).Error
+	version.Content = content
+	return err
 }
 
 func (r *VersionRepository) GetByID(id uint) (*article.ArticleVersion, error) {
 	var version article.ArticleVersion
-	err := r.db.First(&version, id).Error
+	if err := r.db.First(&version, id).Error; err != nil {
+		return &version, err
+	}
+	err := r.materialize(&version)
 	return &version, err
 }
 
+// GetContent 获取版本的完整内容（自动从快照/增量还原）
 func (r *VersionRepository) GetContent(versionID uint) (string, error) {
+	version, err := r.GetByID(versionID)
+	if err != nil {
+		return "", err
+	}
+	return version.Content, nil
+}
+
+// GetByVersionNumber 根据文章ID和版本号获取版本
+func (r *VersionRepository) GetByVersionNumber(articleID uint, versionNumber int) (*article.ArticleVersion, error) {
 	var version article.ArticleVersion
-	err := r.db.Select("content").First(&version, versionID).Error
-	return version.Content, err
+	if err := r.db.Where("article_id = ? AND version_number = ?", articleID, versionNumber).
+		First(&version).Error; err != nil {
+		return &version, err
+	}
+	err := r.materialize(&version)
+	return &version, err
+}
+
+// GetContents 批量获取多个版本的完整内容（同一次读取中共享已还原的基础版本），返回 versionID -> content
+func (r *VersionRepository) GetContents(versionIDs []uint) (map[uint]string, error) {
+	result := make(map[uint]string)
+	if len(versionIDs) == 0 {
+		return result, nil
+	}
+	var versions []article.ArticleVersion
+	if err := r.db.Where("id IN ?", versionIDs).Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	ptrs := make([]*article.ArticleVersion, len(versions))
+	for i := range versions {
+		ptrs[i] = &versions[i]
+	}
+	if err := r.materialize(ptrs...); err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		result[v.ID] = v.Content
+	}
+	return result, nil
 }
 
 // GetNextVersionNumber 获取下一个版本号（文章维度递增）
@@ -218,12 +1456,49 @@ func (r *VersionRepository) GetNextVersionNumber(articleID uint) int {
 // GetVersions 获取文章的所有版本
 func (r *VersionRepository) GetVersions(articleID uint) ([]article.ArticleVersion, error) {
 	var versions []article.ArticleVersion
-	err := r.db.Where("article_id = ?", articleID).
+	if err := r.db.Where("article_id = ?", articleID).
+		Order("version_number DESC").
+		Find(&versions).Error; err != nil {
+		return versions, err
+	}
+
+	ptrs := make([]*article.ArticleVersion, len(versions))
+	for i := range versions {
+		ptrs[i] = &versions[i]
+	}
+	err := r.materialize(ptrs...)
+	return versions, err
+}
+
+// ListVersionMeta 获取文章所有版本的元数据（按版本号降序，不读取和还原内容，Content 为空）
+// 用于只需要版本号、状态、提交信息等字段的列表（文章历史），避免逐个解压和回放增量
+func (r *VersionRepository) ListVersionMeta(articleID uint) ([]article.ArticleVersion, error) {
+	var versions []article.ArticleVersion
+	err := r.db.Omit("content", "stored_content").
+		Where("article_id = ?", articleID).
 		Order("version_number DESC").
 		Find(&versions).Error
 	return versions, err
 }
 
+// GetCommitMessages 批量获取版本的版本号和提交信息（只查询这两列）
+func (r *VersionRepository) GetCommitMessages(versionIDs []uint) (map[uint]article.ArticleVersion, error) {
+	result := make(map[uint]article.ArticleVersion)
+	if len(versionIDs) == 0 {
+		return result, nil
+	}
+	var versions []article.ArticleVersion
+	if err := r.db.Select("id", "version_number", "commit_message").
+		Where("id IN ?", versionIDs).
+		Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		result[v.ID] = v
+	}
+	return result, nil
+}
+
 // UpdateStatus 更新版本状态
 func (r *VersionRepository) UpdateStatus(versionID uint, status string) error {
 	return r.db.Model(&article.ArticleVersion{}).
@@ -231,9 +1506,16 @@ func (r *VersionRepository) UpdateStatus(versionID uint, status string) error {
 		Update("status", status).Error
 }
 
-// Update 更新版本完整信息
+// Update 更新版本完整信息（内容重新压缩存储）
 func (r *VersionRepository) Update(version *article.ArticleVersion) error {
-	return r.db.Save(version).Error
+	content := version.Content
+	if err := r.encodeContent(version); err != nil {
+		version.Content = content
+		return err
+	}
+	err := r.db.Save(version).Error
+	version.Content = content
+	return err
 }
 
 // GetVersionNumber 获取版本的版本号
@@ -243,6 +1525,31 @@ func (r *VersionRepository) GetVersionNumber(versionID uint) (int, error) {
 	return version.VersionNumber, err
 }
 
+// blameCacheTTL blame 结果缓存时间（历史版本不可变，仅用于回收不再访问的旧版本缓存）
+const blameCacheTTL = 7 * 24 * time.Hour
+
+// GetCachedBlame 获取指定版本的 blame 缓存
+func (r *VersionRepository) GetCachedBlame(versionID uint) (string, bool) {
+	if database.RedisDB == nil {
+		return "", false
+	}
+	key := fmt.Sprintf("article:blame:%d", versionID)
+	data, err := database.RedisDB.Get(context.Background(), key).Result()
+	if err != nil {
+		return "", false
+	}
+	return data, true
+}
+
+// CacheBlame 缓存指定版本的 blame 结果（缓存失败不影响主流程）
+func (r *VersionRepository) CacheBlame(versionID uint, data string) {
+	if database.RedisDB == nil {
+		return
+	}
+	key := fmt.Sprintf("article:blame:%d", versionID)
+	database.RedisDB.Set(context.Background(), key, data, blameCacheTTL)
+}
+
 // SubmissionRepository 提交审核仓储层
 type SubmissionRepository struct {
 	db *gorm.DB
@@ -286,18 +1593,20 @@ func (r *SubmissionRepository) GetPendingByArticle(articleID uint) ([]article.Re
 
 // GetReviews 获取审核列表
 func (r *SubmissionRepository) GetReviews(status string, articleID *uint) ([]article.ReviewSubmission, error) {
-	query := r.db.Model(&article.ReviewSubmission{})
+	// 回收站中文章的提交不返回
+	query := r.db.Model(&article.ReviewSubmission{}).
+		Joins("JOIN articles ON articles.id = review_submissions.article_id AND articles.deleted_at IS NULL")
 
 	if status != "all" {
-		query = query.Where("status = ?", status)
+		query = query.Where("review_submissions.status = ?", status)
 	}
 
 	if articleID != nil {
-		query = query.Where("article_id = ?", *articleID)
+		query = query.Where("review_submissions.article_id = ?", *articleID)
 	}
 
 	var submissions []article.ReviewSubmission
-	err := query.Order("created_at DESC").Find(&submissions).Error
+	err := query.Order("review_submissions.created_at DESC").Find(&submissions).Error
 	return submissions, err
 }
 
@@ -310,7 +1619,7 @@ func (r *SubmissionRepository) CreateConflict(conflict *article.VersionConflict)
 func (r *SubmissionRepository) ResolveConflict(submissionID uint, resolvedVersionID uint, resolvedBy uint) error {
 	now := time.Now()
 	return r.db.Model(&article.VersionConflict{}).
-		Where("submission_id = ?", submissionID).
+		Where("submission_id = ? AND status = ?", submissionID, "detected").
 		Updates(map[string]interface{}{
 			"status":              "resolved",
 			"resolved_version_id": resolvedVersionID,
@@ -319,10 +1628,75 @@ func (r *SubmissionRepository) ResolveConflict(submissionID uint, resolvedVersio
 		}).Error
 }
 
-// GetConflictBySubmission 获取提交的冲突记录
+// CreateRevision 记录提交的历史修订
+func (r *SubmissionRepository) CreateRevision(revision *article.SubmissionRevision) error {
+	return r.db.Create(revision).Error
+}
+
+// ListRevisions 获取提交的历史修订（按修订序号升序）
+func (r *SubmissionRepository) ListRevisions(submissionID uint) ([]article.SubmissionRevision, error) {
+	var revisions []article.SubmissionRevision
+	err := r.db.Where("submission_id = ?", submissionID).
+		Order("revision_number ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+// SaveVote 记录审核人的投票（同一审核人重复投票时覆盖）
+func (r *SubmissionRepository) SaveVote(vote *article.ReviewVote) error {
+	var existing article.ReviewVote
+	err := r.db.Where("submission_id = ? AND reviewer_id = ?", vote.SubmissionID, vote.ReviewerID).First(&existing).Error
+	if err == nil {
+		vote.ID = existing.ID
+		vote.CreatedAt = existing.CreatedAt
+	}
+	return r.db.Save(vote).Error
+}
+
+// ListVotes 获取提交的所有投票（按投票时间升序）
+func (r *SubmissionRepository) ListVotes(submissionID uint) ([]article.ReviewVote, error) {
+	var votes []article.ReviewVote
+	err := r.db.Where("submission_id = ?", submissionID).
+		Order("updated_at ASC").
+		Find(&votes).Error
+	return votes, err
+}
+
+// ClearVotes 清空提交的投票（提交内容更新后需要重新审核）
+func (r *SubmissionRepository) ClearVotes(submissionID uint) error {
+	return r.db.Where("submission_id = ?", submissionID).Delete(&article.ReviewVote{}).Error
+}
+
+// CreateComment 创建审核行内评论
+func (r *SubmissionRepository) CreateComment(comment *article.ReviewComment) error {
+	return r.db.Create(comment).Error
+}
+
+// GetCommentByID 获取审核行内评论
+func (r *SubmissionRepository) GetCommentByID(id uint) (*article.ReviewComment, error) {
+	var comment article.ReviewComment
+	err := r.db.First(&comment, id).Error
+	return &comment, err
+}
+
+// ListComments 获取提交的所有行内评论（按创建时间升序）
+func (r *SubmissionRepository) ListComments(submissionID uint) ([]article.ReviewComment, error) {
+	var comments []article.ReviewComment
+	err := r.db.Where("submission_id = ?", submissionID).
+		Order("created_at ASC, id ASC").
+		Find(&comments).Error
+	return comments, err
+}
+
+// UpdateComment 更新审核行内评论
+func (r *SubmissionRepository) UpdateComment(comment *article.ReviewComment) error {
+	return r.db.Save(comment).Error
+}
+
+// GetConflictBySubmission 获取提交的冲突记录（同一提交多次检测到冲突时返回最新一条）
 func (r *SubmissionRepository) GetConflictBySubmission(submissionID uint) (*article.VersionConflict, error) {
 	var conflict article.VersionConflict
-	err := r.db.Where("submission_id = ?", submissionID).First(&conflict).Error
+	err := r.db.Where("submission_id = ?", submissionID).Order("id DESC").First(&conflict).Error
 	return &conflict, err
 }
 
@@ -386,28 +1760,160 @@ func (r *TagRepository) GetArticleTags(articleID uint) ([]article.Tag, error) {
 	return tags, err
 }
 
+// GetByID 根据ID获取标签
+func (r *TagRepository) GetByID(id uint) (*article.Tag, error) {
+	var tag article.Tag
+	err := r.db.First(&tag, id).Error
+	return &tag, err
+}
+
+// GetByName 根据名称获取标签
+func (r *TagRepository) GetByName(name string) (*article.Tag, error) {
+	var tag article.Tag
+	err := r.db.Where("name = ?", name).First(&tag).Error
+	return &tag, err
+}
+
+// usageQuery 标签及其使用次数（只统计未删除的文章）
+func (r *TagRepository) usageQuery() *gorm.DB {
+	return r.db.Table("tags").
+		Select("tags.id, tags.name, tags.color, tags.created_at, COUNT(articles.id) AS usage_count").
+		Joins("LEFT JOIN article_tags ON article_tags.tag_id = tags.id").
+		Joins("LEFT JOIN articles ON articles.id = article_tags.article_id AND articles.deleted_at IS NULL").
+		Group("tags.id")
+}
+
+// likePatternEscaper 转义 LIKE 模式中的通配符（PostgreSQL 默认以反斜杠为转义符）
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLikePattern 转义用户输入，使其在 LIKE/ILIKE 中按字面匹配
+func escapeLikePattern(s string) string {
+	return likePatternEscaper.Replace(s)
+}
+
+// ListWithUsage 分页获取标签及使用次数
+// keyword 非空时按名称模糊匹配；sortBy 为 "name" 时按名称排序，否则按使用次数倒序
+func (r *TagRepository) ListWithUsage(keyword string, sortBy string, offset, limit int) ([]TagUsage, int64, error) {
+	var tags []TagUsage
+	var total int64
+
+	countQuery := r.db.Model(&article.Tag{})
+	query := r.usageQuery()
+	if keyword != "" {
+		pattern := "%" + escapeLikePattern(keyword) + "%"
+		countQuery = countQuery.Where("name ILIKE ?", pattern)
+		query = query.Where("tags.name ILIKE ?", pattern)
+	}
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if sortBy == "name" {
+		query = query.Order("tags.name ASC")
+	} else {
+		query = query.Order("usage_count DESC, tags.name ASC")
+	}
+	err := query.Offset(offset).Limit(limit).Scan(&tags).Error
+	return tags, total, err
+}
+
+// SearchByPrefix 按名称前缀查找标签（按使用次数倒序）
+func (r *TagRepository) SearchByPrefix(prefix string, limit int) ([]TagUsage, error) {
+	var tags []TagUsage
+	err := r.usageQuery().
+		Where("tags.name ILIKE ?", escapeLikePattern(prefix)+"%").
+		Order("usage_count DESC, tags.name ASC").
+		Limit(limit).
+		Scan(&tags).Error
+	return tags, err
+}
+
+// GetWithUsage 获取单个标签及使用次数
+func (r *TagRepository) GetWithUsage(id uint) (*TagUsage, error) {
+	var tags []TagUsage
+	if err := r.usageQuery().Where("tags.id = ?", id).Scan(&tags).Error; err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &tags[0], nil
+}
+
+// CountArticleTags 统计标签的关联记录数（包含回收站中的文章）
+func (r *TagRepository) CountArticleTags(tagID uint) int64 {
+	var count int64
+	r.db.Model(&article.ArticleTag{}).Where("tag_id = ?", tagID).Count(&count)
+	return count
+}
 
-// DeleteArticleWithCascade 级联删除文章及其所有关联数据（软删除）
-// 删除顺序：favorites -> article_tags -> article_collaborators -> version_conflicts -> review_submissions -> article_versions -> article
-// 注意：Article 使用软删除（设置 DeletedAt），其他关联表使用硬删除
-func (r *ArticleRepository) DeleteArticleWithCascade(articleID uint) error {
+// UpdateTag 更新标签名称或颜色
+func (r *TagRepository) UpdateTag(tagID uint, updates map[string]interface{}) error {
+	return r.db.Model(&article.Tag{}).Where("id = ?", tagID).Updates(updates).Error
+}
+
+// DeleteTag 删除标签
+func (r *TagRepository) DeleteTag(tagID uint) error {
+	return r.db.Delete(&article.Tag{}, tagID).Error
+}
+
+// MergeTags 将 sourceIDs 标签合并到 targetID
+// 在同一事务中把源标签的文章关联改为目标标签（已有目标标签的文章跳过），然后删除源标签
+func (r *TagRepository) MergeTags(sourceIDs []uint, targetID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			INSERT INTO article_tags (article_id, tag_id, created_at)
+			SELECT article_id, ?, MIN(created_at) FROM article_tags
+			WHERE tag_id IN ? AND article_id NOT IN (SELECT article_id FROM article_tags WHERE tag_id = ?)
+			GROUP BY article_id
+		`, targetID, sourceIDs, targetID).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("tag_id IN ?", sourceIDs).Delete(&article.ArticleTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", sourceIDs).Delete(&article.Tag{}).Error
+	})
+}
+
+// PurgeArticleWithCascade 永久删除回收站中的文章及其所有关联数据（不可恢复）
+// 删除顺序：favorites -> article_tags -> article_references -> article_search_index -> article_daily_stats -> article_collaborators -> article_drafts -> article_moves -> version_conflicts -> submission_revisions -> review_votes -> review_comments -> review_submissions -> article_versions -> article
+// 注意：所有表均为硬删除，Article 使用 Unscoped 绕过软删除
+func (r *ArticleRepository) PurgeArticleWithCascade(articleID uint) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// 1. 删除收藏记录（硬删除）
 		if err := tx.Where("article_id = ?", articleID).Delete(&article.Favorite{}).Error; err != nil {
 			return err
 		}
 
-		// 2. 删除文章标签关联（硬删除）
+		// 2. 删除文章标签关联、引用关系、搜索索引和阅读统计（硬删除）
 		if err := tx.Where("article_id = ?", articleID).Delete(&article.ArticleTag{}).Error; err != nil {
 			return err
 		}
+		if err := tx.Where("from_article_id = ? OR to_article_id = ?", articleID, articleID).Delete(&article.ArticleReference{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("article_id = ?", articleID).Delete(&article.ArticleSearchIndex{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("article_id = ?", articleID).Delete(&article.ArticleDailyStat{}).Error; err != nil {
+			return err
+		}
 
 		// 3. 删除协作者（硬删除）
 		if err := tx.Where("article_id = ?", articleID).Delete(&article.ArticleCollaborator{}).Error; err != nil {
 			return err
 		}
 
-		// 4. 获取所有 submission IDs 用于删除冲突记录
+		// 4. 删除个人草稿和移动记录（硬删除）
+		if err := tx.Where("article_id = ?", articleID).Delete(&article.ArticleDraft{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("article_id = ?", articleID).Delete(&article.ArticleMove{}).Error; err != nil {
+			return err
+		}
+
+		// 5. 获取所有 submission IDs 用于删除冲突记录
 		var submissionIDs []uint
 		if err := tx.Model(&article.ReviewSubmission{}).
 			Where("article_id = ?", articleID).
@@ -415,26 +1921,34 @@ func (r *ArticleRepository) DeleteArticleWithCascade(articleID uint) error {
 			return err
 		}
 
-		// 5. 删除版本冲突记录（硬删除）
+		// 6. 删除版本冲突记录、提交修订记录、审核投票和行内评论（硬删除）
 		if len(submissionIDs) > 0 {
 			if err := tx.Where("submission_id IN ?", submissionIDs).Delete(&article.VersionConflict{}).Error; err != nil {
 				return err
 			}
+			if err := tx.Where("submission_id IN ?", submissionIDs).Delete(&article.SubmissionRevision{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("submission_id IN ?", submissionIDs).Delete(&article.ReviewVote{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("submission_id IN ?", submissionIDs).Delete(&article.ReviewComment{}).Error; err != nil {
+				return err
+			}
 		}
 
-		// 6. 删除审核提交记录（硬删除）
+		// 7. 删除审核提交记录（硬删除）
 		if err := tx.Where("article_id = ?", articleID).Delete(&article.ReviewSubmission{}).Error; err != nil {
 			return err
 		}
 
-		// 7. 删除所有版本（硬删除）
+		// 8. 删除所有版本（硬删除）
 		if err := tx.Where("article_id = ?", articleID).Delete(&article.ArticleVersion{}).Error; err != nil {
 			return err
 		}
 
-		// 8. 软删除文章本身（GORM 会自动检测 DeletedAt 字段并执行软删除）
-		// 使用 Delete 方法，GORM 会自动执行 UPDATE articles SET deleted_at = NOW() WHERE id = ?
-		if err := tx.Delete(&article.Article{}, articleID).Error; err != nil {
+		// 9. 删除文章本身（硬删除）
+		if err := tx.Unscoped().Delete(&article.Article{}, articleID).Error; err != nil {
 			return err
 		}
 
@@ -0,0 +1,196 @@
+package article
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// AddReviewComment 在提交的 proposed 版本上添加行内评论或回复
+// 权限要求：文章 owner/admin/moderator 或提交者本人；提交必须处于审核中
+func (s *ArticleService) AddReviewComment(submissionID uint, userID uint, req dto.ReviewCommentRequest) (*article.ReviewComment, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, errors.New("提交不存在")
+	}
+
+	if submission.SubmittedBy != userID && !s.articleRepo.CheckPermission(submission.ArticleID, userID, "", "moderator") {
+		return nil, errors.New("permission denied: only reviewers and the submitter can comment on submissions")
+	}
+	if submission.Status != "pending" && submission.Status != "conflict_detected" && submission.Status != "changes_requested" {
+		return nil, errors.New("该提交已关闭，无法评论")
+	}
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, errors.New("评论内容不能为空")
+	}
+
+	now := time.Now()
+	comment := &article.ReviewComment{
+		SubmissionID: submissionID,
+		AuthorID:     userID,
+		Content:      req.Content,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if req.ParentID != 0 {
+		// 回复：挂在根评论下，锚点沿用根评论
+		parent, err := s.submissionRepo.GetCommentByID(req.ParentID)
+		if err != nil || parent.SubmissionID != submissionID {
+			return nil, errors.New("回复的评论不存在")
+		}
+		if parent.ParentID != nil {
+			if parent, err = s.submissionRepo.GetCommentByID(*parent.ParentID); err != nil {
+				return nil, errors.New("回复的评论不存在")
+			}
+		}
+		comment.ParentID = &parent.ID
+		comment.VersionID = parent.VersionID
+		comment.StartLine = parent.StartLine
+		comment.EndLine = parent.EndLine
+		comment.OriginalVersionID = parent.OriginalVersionID
+		comment.OriginalStartLine = parent.OriginalStartLine
+		comment.OriginalEndLine = parent.OriginalEndLine
+		comment.IsOutdated = parent.IsOutdated
+	} else {
+		content, err := s.versionRepo.GetContent(submission.ProposedVersionID)
+		if err != nil {
+			return nil, err
+		}
+		lineCount := len(splitLines(content))
+		if req.StartLine < 1 || req.EndLine < req.StartLine || req.EndLine > lineCount {
+			return nil, errors.New("评论的行范围无效")
+		}
+		comment.VersionID = submission.ProposedVersionID
+		comment.StartLine = req.StartLine
+		comment.EndLine = req.EndLine
+		comment.OriginalVersionID = submission.ProposedVersionID
+		comment.OriginalStartLine = req.StartLine
+		comment.OriginalEndLine = req.EndLine
+	}
+
+	if err := s.submissionRepo.CreateComment(comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// ListReviewComments 获取提交的行内评论，按根评论组织为讨论串
+func (s *ArticleService) ListReviewComments(submissionID uint) ([]map[string]interface{}, error) {
+	comments, err := s.submissionRepo.ListComments(submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	threads := make([]map[string]interface{}, 0)
+	byRoot := make(map[uint]map[string]interface{})
+	for _, c := range comments {
+		if c.ParentID == nil {
+			thread := buildReviewCommentInfo(&c)
+			thread["replies"] = []map[string]interface{}{}
+			byRoot[c.ID] = thread
+			threads = append(threads, thread)
+		}
+	}
+	for _, c := range comments {
+		if c.ParentID == nil {
+			continue
+		}
+		if thread, ok := byRoot[*c.ParentID]; ok {
+			thread["replies"] = append(thread["replies"].([]map[string]interface{}), buildReviewCommentInfo(&c))
+		}
+	}
+	return threads, nil
+}
+
+// reanchorReviewComments 提交内容更新后，将根评论的锚点从旧内容重新定位到新内容
+// 锚定范围内仍保留的行决定新的范围；全部被删除时保留原范围并标记为过时
+func (s *ArticleService) reanchorReviewComments(submissionID uint, oldContent, newContent string, newVersionID uint) {
+	comments, err := s.submissionRepo.ListComments(submissionID)
+	if err != nil || len(comments) == 0 {
+		return
+	}
+
+	// 去掉行尾换行符后再比较，避免末行追加内容时把原末行误判为修改
+	oldLines := splitLines(oldContent)
+	for i := range oldLines {
+		oldLines[i] = strings.TrimSuffix(oldLines[i], "\n")
+	}
+	newLines := splitLines(newContent)
+	for i := range newLines {
+		newLines[i] = strings.TrimSuffix(newLines[i], "\n")
+	}
+	lineMap := make(map[int]int)
+	for _, m := range matchLines(oldLines, newLines) {
+		lineMap[m.a+1] = m.b + 1
+	}
+
+	anchors := make(map[uint]article.ReviewComment)
+	for i := range comments {
+		c := &comments[i]
+		if c.ParentID != nil || c.IsOutdated {
+			continue
+		}
+		start, end := 0, 0
+		for line := c.StartLine; line <= c.EndLine; line++ {
+			if mapped, ok := lineMap[line]; ok {
+				if start == 0 {
+					start = mapped
+				}
+				end = mapped
+			}
+		}
+		if start == 0 {
+			c.IsOutdated = true
+		} else {
+			c.VersionID = newVersionID
+			c.StartLine = start
+			c.EndLine = end
+		}
+		anchors[c.ID] = *c
+	}
+
+	// 回复与根评论保持一致
+	for i := range comments {
+		c := &comments[i]
+		root, ok := anchors[c.ID]
+		if !ok && c.ParentID != nil {
+			root, ok = anchors[*c.ParentID]
+		}
+		if !ok {
+			continue
+		}
+		c.VersionID = root.VersionID
+		c.StartLine = root.StartLine
+		c.EndLine = root.EndLine
+		c.IsOutdated = root.IsOutdated
+		if err := s.submissionRepo.UpdateComment(c); err != nil {
+			// TODO: 生产环境优化 - 移除或使用结构化日志
+			log.Printf("[reanchorReviewComments] 更新评论锚点失败, commentID=%d, err=%v", c.ID, err)
+		}
+	}
+}
+
+// buildReviewCommentInfo 构造行内评论返回数据
+func buildReviewCommentInfo(c *article.ReviewComment) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                  c.ID,
+		"submission_id":       c.SubmissionID,
+		"parent_id":           c.ParentID,
+		"version_id":          c.VersionID,
+		"start_line":          c.StartLine,
+		"end_line":            c.EndLine,
+		"original_version_id": c.OriginalVersionID,
+		"original_start_line": c.OriginalStartLine,
+		"original_end_line":   c.OriginalEndLine,
+		"is_outdated":         c.IsOutdated,
+		"author_id":           c.AuthorID,
+		"content":             c.Content,
+		"created_at":          c.CreatedAt,
+		"updated_at":          c.UpdatedAt,
+	}
+}
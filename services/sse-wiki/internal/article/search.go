@@ -0,0 +1,284 @@
+package article
+
+import (
+	"errors"
+	"html"
+	"log"
+	"strings"
+	"unicode"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+const (
+	// snippetLength 搜索摘要长度（字符数）
+	snippetLength = 120
+	// snippetLeadLength 摘要中第一个命中词之前保留的字符数
+	snippetLeadLength = 40
+	// reindexBatchSize 每批重建索引的文章数
+	reindexBatchSize = 100
+)
+
+// tokenize 将文本切分为搜索词
+// 连续的汉字按二元组切分（indexUnigrams 为 true 时同时输出单字，使单字查询也能命中），
+// 其他字母数字按单词切分并转为小写，其余字符视为分隔符
+func tokenize(text string, indexUnigrams bool) []string {
+	var tokens []string
+	var word, han []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushHan := func() {
+		if len(han) == 1 {
+			tokens = append(tokens, string(han))
+		} else {
+			for i := range han {
+				if indexUnigrams {
+					tokens = append(tokens, string(han[i]))
+				}
+				if i+1 < len(han) {
+					tokens = append(tokens, string(han[i:i+2]))
+				}
+			}
+		}
+		han = han[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+	return tokens
+}
+
+// upsertSearchIndex 按文章当前标题和版本内容写入搜索索引
+func (s *ArticleService) upsertSearchIndex(art *article.Article, content string) error {
+	plain := stripHTMLTags(content)
+	return s.articleRepo.UpsertSearchIndex(art.ID, *art.CurrentVersionID, art.Title,
+		strings.Join(tokenize(art.Title, true), " "),
+		strings.Join(tokenize(plain, true), " "))
+}
+
+// reindexArticle 更新文章的搜索索引，失败只记录日志（启动时的 ReindexStaleArticles 会补建）
+func (s *ArticleService) reindexArticle(art *article.Article, content string) {
+	if art.CurrentVersionID == nil {
+		return
+	}
+	if err := s.upsertSearchIndex(art, content); err != nil {
+		// TODO: 生产环境优化 - 移除或使用结构化日志
+		log.Printf("[reindexArticle] 更新搜索索引失败, articleID=%d, err=%v", art.ID, err)
+	}
+}
+
+// onVersionPublished 文章发布新版本（CurrentVersionID 变化）后同步内部链接和搜索索引
+func (s *ArticleService) onVersionPublished(art *article.Article, content string) {
+	s.syncWikiLinks(art, content)
+	s.reindexArticle(art, content)
+}
+
+// ReindexStaleArticles 为索引缺失或过期的文章重建搜索索引，返回处理的文章数
+// 用于服务启动时补建历史数据，日常更新由发布流程增量完成
+func (s *ArticleService) ReindexStaleArticles() (int, error) {
+	reindexed := 0
+	for {
+		articles, err := s.articleRepo.ListStaleSearchIndex(reindexBatchSize)
+		if err != nil {
+			return reindexed, err
+		}
+		if len(articles) == 0 {
+			return reindexed, nil
+		}
+		for i := range articles {
+			art := &articles[i]
+			content, err := s.versionRepo.GetContent(*art.CurrentVersionID)
+			if err != nil {
+				return reindexed, err
+			}
+			if err := s.upsertSearchIndex(art, content); err != nil {
+				return reindexed, err
+			}
+			reindexed++
+		}
+	}
+}
+
+// SearchArticles 全文搜索文章标题和当前版本内容
+// 所有关键词都需命中，结果按相关度排序（标题命中权重高于正文），并返回高亮的标题和摘要（命中词以 <mark> 包围）
+// moduleID 非 0 时只搜索该模块及其子模块；tagNames 非空时文章需包含全部标签
+func (s *ArticleService) SearchArticles(query string, moduleID uint, tagNames []string, page, pageSize int) (map[string]interface{}, error) {
+	queryTokens := tokenize(query, false)
+	if len(queryTokens) == 0 {
+		return nil, errors.New("搜索关键词不能为空")
+	}
+
+	var moduleIDs []uint
+	if moduleID != 0 {
+		if !s.articleRepo.ModuleExists(moduleID) {
+			return nil, errors.New("模块不存在")
+		}
+		ids, err := s.articleRepo.GetModuleSubtreeIDs(moduleID)
+		if err != nil {
+			return nil, err
+		}
+		moduleIDs = ids
+	}
+
+	seen := make(map[string]bool)
+	var tags []string
+	for _, name := range tagNames {
+		name = strings.TrimSpace(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			tags = append(tags, name)
+		}
+	}
+
+	offset := (page - 1) * pageSize
+	hits, total, err := s.articleRepo.Search(strings.Join(queryTokens, " "), moduleIDs, tags, offset, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ArticleID
+	}
+	articles, err := s.articleRepo.GetByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]article.Article, len(articles))
+	for _, art := range articles {
+		byID[art.ID] = art
+	}
+
+	// 摘要只为当前页的结果从索引对应的版本内容生成，索引表不保存正文
+	versionIDs := make([]uint, len(hits))
+	for i, hit := range hits {
+		versionIDs[i] = hit.VersionID
+	}
+	contents, err := s.versionRepo.GetContents(versionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := highlightTerms(query)
+	ordered := make([]article.Article, 0, len(hits))
+	matched := make([]SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		if art, ok := byID[hit.ArticleID]; ok {
+			ordered = append(ordered, art)
+			matched = append(matched, hit)
+		}
+	}
+	results := s.buildArticleListItems(ordered)
+	for i, item := range results {
+		item["title_highlight"] = highlightText([]rune(ordered[i].Title), terms, 0, len([]rune(ordered[i].Title)))
+		item["snippet"] = buildSnippet(stripHTMLTags(contents[matched[i].VersionID]), terms)
+		item["rank"] = matched[i].Rank
+	}
+
+	return map[string]interface{}{
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+		"results":   results,
+	}, nil
+}
+
+// highlightTerms 提取用于高亮的关键词（按空白分隔，小写，长词优先）
+func highlightTerms(query string) [][]rune {
+	var terms [][]rune
+	for _, field := range strings.Fields(query) {
+		terms = append(terms, []rune(strings.ToLower(field)))
+	}
+	// 长词优先匹配，避免短词截断长词的高亮
+	for i := 1; i < len(terms); i++ {
+		for j := i; j > 0 && len(terms[j]) > len(terms[j-1]); j-- {
+			terms[j], terms[j-1] = terms[j-1], terms[j]
+		}
+	}
+	return terms
+}
+
+// matchTermAt 返回在 text[i:] 处命中的关键词长度（不区分大小写），未命中返回 0
+func matchTermAt(text []rune, i int, terms [][]rune) int {
+	for _, term := range terms {
+		if len(term) == 0 || i+len(term) > len(text) {
+			continue
+		}
+		matched := true
+		for k, r := range term {
+			if unicode.ToLower(text[i+k]) != r {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return len(term)
+		}
+	}
+	return 0
+}
+
+// highlightText 转义 text[start:end] 并用 <mark> 包围命中的关键词
+func highlightText(text []rune, terms [][]rune, start, end int) string {
+	var b strings.Builder
+	for i := start; i < end; {
+		if n := matchTermAt(text, i, terms); n > 0 && i+n <= end {
+			b.WriteString("<mark>")
+			b.WriteString(html.EscapeString(string(text[i : i+n])))
+			b.WriteString("</mark>")
+			i += n
+			continue
+		}
+		b.WriteString(html.EscapeString(string(text[i])))
+		i++
+	}
+	return b.String()
+}
+
+// buildSnippet 截取第一个命中词附近的正文作为摘要，未命中时取正文开头
+func buildSnippet(content string, terms [][]rune) string {
+	text := []rune(content)
+	first := -1
+	for i := range text {
+		if matchTermAt(text, i, terms) > 0 {
+			first = i
+			break
+		}
+	}
+
+	start := 0
+	if first > snippetLeadLength {
+		start = first - snippetLeadLength
+	}
+	end := start + snippetLength
+	if end > len(text) {
+		end = len(text)
+	}
+
+	snippet := highlightText(text, terms, start, end)
+	if start > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(text) {
+		snippet += "..."
+	}
+	return snippet
+}
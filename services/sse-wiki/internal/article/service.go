@@ -10,6 +10,7 @@ import (
 
 	"terminal-terrace/sse-wiki/internal/dto"
 	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/permission"
 )
 
 type ArticleService struct {
@@ -18,6 +19,8 @@ type ArticleService struct {
 	submissionRepo *SubmissionRepository
 	tagRepo        *TagRepository
 	mergeService   *MergeService
+	// 模块权限检查（含继承），与文章仓储共用数据库连接
+	permissionService *permission.PermissionService
 }
 
 func NewArticleService(
@@ -28,11 +31,12 @@ func NewArticleService(
 	mergeService *MergeService,
 ) *ArticleService {
 	return &ArticleService{
-		articleRepo:    articleRepo,
-		versionRepo:    versionRepo,
-		submissionRepo: submissionRepo,
-		tagRepo:        tagRepo,
-		mergeService:   mergeService,
+		articleRepo:       articleRepo,
+		versionRepo:       versionRepo,
+		submissionRepo:    submissionRepo,
+		tagRepo:           tagRepo,
+		mergeService:      mergeService,
+		permissionService: permission.NewPermissionService(articleRepo.db),
 	}
 }
 
@@ -93,7 +97,10 @@ func (s *ArticleService) CreateArticle(req dto.CreateArticleRequest, userID uint
 		}
 	}
 
-	// 6. 返回完整的文章详情（包括content、tags等）
+	// 6. 同步正文中的内部链接并建立搜索索引
+	s.onVersionPublished(art, req.Content)
+
+	// 7. 返回完整的文章详情（包括content、tags等）
 	// 创建者自动成为 owner，传空字符串让 GetArticle 从数据库读取角色
 	return s.GetArticle(art.ID, userID, "")
 }
@@ -158,22 +165,9 @@ func (s *ArticleService) CreateSubmission(articleID uint, req dto.SubmissionRequ
 			// TODO: 生产环境优化 - 移除或使用结构化日志
 			log.Printf("[CreateSubmission] 3路合并检测到冲突, articleID=%d", articleID)
 
-			baseVersionNumber, _ := s.versionRepo.GetVersionNumber(req.BaseVersionID)
-			currentVersionNumber := 0
-			if art.CurrentVersionID != nil {
-				if num, err := s.versionRepo.GetVersionNumber(*art.CurrentVersionID); err == nil {
-					currentVersionNumber = num
-				}
-			}
-
 			return nil, nil, &MergeConflictError{
-				Message: "Merge conflict detected",
-				ConflictData: map[string]interface{}{
-					"has_conflict":           true,
-					"base_version_number":    baseVersionNumber,
-					"current_version_number": currentVersionNumber,
-					"submitter_name":         "User",
-				},
+				Message:      "Merge conflict detected",
+				ConflictData: s.buildConflictData(req.BaseVersionID, art.CurrentVersionID, userID, mergeResult.Conflicts),
 			}
 		}
 
@@ -210,6 +204,7 @@ func (s *ArticleService) CreateSubmission(articleID uint, req dto.SubmissionRequ
 		if err := s.articleRepo.Update(art); err != nil {
 			return nil, nil, err
 		}
+		s.onVersionPublished(art, publishedVersion.Content)
 
 		// 返回nil表示无需审核（直接发布成功）
 		return nil, publishedVersion, nil
@@ -266,6 +261,7 @@ func (s *ArticleService) CreateSubmission(articleID uint, req dto.SubmissionRequ
 	return submission, nil, nil
 }
 
+// GetUserFavouriteArticle 获取用户收藏的文章ID（回收站中的文章不返回）
 func (s *ArticleService) GetUserFavouriteArticle(userId uint) ([]uint32, error) {
 	return s.articleRepo.GetFavoriteByUserId(userId)
 }
@@ -282,6 +278,10 @@ func (s *ArticleService) ReviewSubmission(submissionID uint, reviewerID uint, us
 		return nil, err
 	}
 
+	if submission.Status == "withdrawn" {
+		return nil, errors.New("该提交已撤回，无法审核")
+	}
+
 	// 2. 获取文章信息
 	art, err := s.articleRepo.GetByID(submission.ArticleID)
 	if err != nil {
@@ -301,6 +301,30 @@ func (s *ArticleService) ReviewSubmission(submissionID uint, reviewerID uint, us
 		}
 	}
 
+	// 4. 多人审核：通过/驳回先记为投票，达到所需人数后才合并或驳回
+	// 免审核模式下的自动合并不需要投票
+	if !isAutoApprove && (req.Action == "approve" || req.Action == "reject") {
+		tally, err := s.recordVote(submission, art, reviewerID, req)
+		if err != nil {
+			return nil, err
+		}
+
+		// TODO: 生产环境优化 - 移除或使用结构化日志
+		log.Printf("[ReviewSubmission] 记录投票, submissionID=%d, reviewerID=%d, vote=%s, approvals=%d, rejections=%d, required=%d",
+			submissionID, reviewerID, req.Action, tally.Approvals, tally.Rejections, tally.Required)
+
+		if req.Action == "approve" && !tally.approved() {
+			result := tally.toMap()
+			result["message"] = "Approval recorded, waiting for more reviewers"
+			return result, nil
+		}
+		if req.Action == "reject" && !tally.rejected() {
+			result := tally.toMap()
+			result["message"] = "Rejection recorded, merge blocked until approvals override it"
+			return result, nil
+		}
+	}
+
 	if req.Action == "approve" {
 		// === 执行3路合并 ===
 
@@ -337,35 +361,23 @@ func (s *ArticleService) ReviewSubmission(submissionID uint, reviewerID uint, us
 			submission.MergedAgainstVersionID = art.CurrentVersionID
 			s.submissionRepo.Update(submission)
 
-			// 记录冲突（只有在存在当前版本时才记录）
+			// 记录冲突（只有在存在当前版本时才记录），冲突区块以 JSON 形式存储
 			if art.CurrentVersionID != nil {
+				conflictDetails, _ := json.Marshal(mergeResult.Conflicts)
 				conflict := &article.VersionConflict{
 					SubmissionID:          submission.ID,
 					ConflictWithVersionID: *art.CurrentVersionID,
 					Status:                "detected",
-					ConflictDetails:       "",
+					ConflictDetails:       string(conflictDetails),
 					CreatedAt:             time.Now(),
 				}
 				s.submissionRepo.CreateConflict(conflict)
 			}
 
-			// 获取版本号信息
-			baseVersionNumber, _ := s.versionRepo.GetVersionNumber(submission.BaseVersionID)
-			currentVersionNumber := 0
-			if art.CurrentVersionID != nil {
-				currentVersionNumber, _ = s.versionRepo.GetVersionNumber(*art.CurrentVersionID)
-			}
-
 			// 返回冲突错误
 			return nil, &MergeConflictError{
-				Message: "Merge conflict detected",
-				ConflictData: map[string]interface{}{
-					"has_conflict":           true,
-					"base_version_number":    baseVersionNumber,
-					"current_version_number": currentVersionNumber,
-					// TODO: 用户服务集成 - 从用户服务获取真实的用户名而不是硬编码
-					"submitter_name": "User",
-				},
+				Message:      "Merge conflict detected",
+				ConflictData: s.buildConflictData(submission.BaseVersionID, art.CurrentVersionID, submission.SubmittedBy, mergeResult.Conflicts),
 			}
 		}
 
@@ -401,6 +413,7 @@ func (s *ArticleService) ReviewSubmission(submissionID uint, reviewerID uint, us
 		if err := s.articleRepo.Update(art); err != nil {
 			return nil, err
 		}
+		s.onVersionPublished(art, finalContent)
 
 		// 审核通过后应用标签（仅用于兼容历史提交数据）
 		// 注意：新的提交不再支持修改标签，此逻辑仅处理历史数据
@@ -455,11 +468,238 @@ func (s *ArticleService) ReviewSubmission(submissionID uint, reviewerID uint, us
 		s.versionRepo.UpdateStatus(submission.ProposedVersionID, "rejected")
 
 		return map[string]interface{}{"message": "Submission rejected"}, nil
+
+	} else if req.Action == "request_changes" {
+		// 要求修改：提交保持打开，等待提交者推送新修订
+		if submission.Status != "pending" && submission.Status != "conflict_detected" {
+			return nil, errors.New("只能对待审核或存在冲突的提交要求修改")
+		}
+		if req.Notes == "" {
+			return nil, errors.New("要求修改时必须填写审核备注")
+		}
+
+		submission.Status = "changes_requested"
+		submission.ReviewedBy = &reviewerID
+		now := time.Now()
+		submission.ReviewedAt = &now
+		submission.ReviewNotes = req.Notes
+		s.submissionRepo.Update(submission)
+
+		return map[string]interface{}{"message": "Changes requested"}, nil
 	}
 
 	return nil, errors.New("invalid action")
 }
 
+// UpdateSubmission 提交者更新自己的提交（变基到当前线上版本）
+// 用于提交者自行解决冲突：以新的内容和基础版本推送新修订（原修订保留为历史），提交重置为 pending 重新进入审核队列，
+// 相关的冲突记录标记为由提交者解决
+// 权限要求：仅提交者本人；提交状态必须为 pending 或 conflict_detected
+func (s *ArticleService) UpdateSubmission(submissionID uint, req dto.UpdateSubmissionRequest, userID uint) (*article.ReviewSubmission, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, errors.New("提交不存在")
+	}
+
+	if submission.SubmittedBy != userID {
+		return nil, errors.New("permission denied: only the submitter can update the submission")
+	}
+	if submission.Status != "pending" && submission.Status != "conflict_detected" {
+		return nil, errors.New("只能更新待审核或存在冲突的提交")
+	}
+
+	art, err := s.articleRepo.GetByID(submission.ArticleID)
+	if err != nil {
+		return nil, errors.New("文章不存在")
+	}
+	if art.CurrentVersionID == nil {
+		return nil, errors.New("文章没有当前版本，数据可能已损坏")
+	}
+
+	// 只能变基到当前线上版本，避免再次与已发布的修改产生冲突
+	baseVersionID := req.BaseVersionID
+	if baseVersionID == 0 {
+		baseVersionID = *art.CurrentVersionID
+	}
+	if baseVersionID != *art.CurrentVersionID {
+		return nil, errors.New("只能基于文章当前版本更新提交，请刷新后重试")
+	}
+
+	hadConflict := submission.Status == "conflict_detected" || submission.HasConflict
+	revisedVersion, revisionNumber, err := s.pushSubmissionRevision(submission, req.Content, req.CommitMessage, baseVersionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[UpdateSubmission] 提交已变基, submissionID=%d, revision=%d, versionID=%d, newBaseVersionID=%d, hadConflict=%v",
+		submission.ID, revisionNumber, revisedVersion.ID, baseVersionID, hadConflict)
+
+	return submission, nil
+}
+
+// ReviseSubmission 提交者在审核人要求修改后推送新修订
+// 原修订保留为历史（版本状态改为 superseded，并记录审核备注），新修订成为提交的 proposed 版本，
+// 提交重新进入审核队列
+// 权限要求：仅提交者本人；提交状态必须为 changes_requested
+func (s *ArticleService) ReviseSubmission(submissionID uint, req dto.ReviseSubmissionRequest, userID uint) (*article.ReviewSubmission, *article.ArticleVersion, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, nil, errors.New("提交不存在")
+	}
+
+	if submission.SubmittedBy != userID {
+		return nil, nil, errors.New("permission denied: only the submitter can revise the submission")
+	}
+	if submission.Status != "changes_requested" {
+		return nil, nil, errors.New("只能修订被要求修改的提交")
+	}
+
+	art, err := s.articleRepo.GetByID(submission.ArticleID)
+	if err != nil {
+		return nil, nil, errors.New("文章不存在")
+	}
+
+	// 默认沿用原基础版本；指定新基础版本时只能是文章当前版本
+	baseVersionID := submission.BaseVersionID
+	if req.BaseVersionID != 0 && req.BaseVersionID != baseVersionID {
+		if art.CurrentVersionID == nil || req.BaseVersionID != *art.CurrentVersionID {
+			return nil, nil, errors.New("只能基于文章当前版本更新提交，请刷新后重试")
+		}
+		baseVersionID = req.BaseVersionID
+	}
+
+	revisedVersion, revisionNumber, err := s.pushSubmissionRevision(submission, req.Content, req.CommitMessage, baseVersionID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[ReviseSubmission] 提交已修订, submissionID=%d, revision=%d, versionID=%d",
+		submission.ID, revisionNumber, revisedVersion.ID)
+
+	return submission, revisedVersion, nil
+}
+
+// pushSubmissionRevision 为提交推送新修订（UpdateSubmission 和 ReviseSubmission 共用）
+// 新内容写入新的 pending 版本，原修订连同审核意见记录到提交历史并标记为 superseded，
+// 提交指向新修订后重新进入审核队列；未解决的冲突由提交者关闭，之前的投票作废
+// 返回新修订的版本和修订序号
+func (s *ArticleService) pushSubmissionRevision(submission *article.ReviewSubmission, content, commitMessage string, baseVersionID uint, userID uint) (*article.ArticleVersion, int, error) {
+	previousVersion, err := s.versionRepo.GetByID(submission.ProposedVersionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if commitMessage == "" {
+		commitMessage = previousVersion.CommitMessage
+	}
+
+	// 1. 创建新修订的pending版本
+	nextVersionNumber := s.versionRepo.GetNextVersionNumber(submission.ArticleID)
+	revisedVersion := &article.ArticleVersion{
+		ArticleID:     submission.ArticleID,
+		VersionNumber: nextVersionNumber,
+		Content:       content,
+		CommitMessage: commitMessage,
+		AuthorID:      userID,
+		Status:        "pending",
+		BaseVersionID: &baseVersionID,
+		CreatedAt:     time.Now(),
+	}
+	if err := s.versionRepo.Create(revisedVersion); err != nil {
+		if isVersionConflictError(err) {
+			return nil, 0, errors.New("版本号冲突，请刷新后重试")
+		}
+		return nil, 0, err
+	}
+
+	// 2. 保留原修订及其审核意见
+	revisions, err := s.submissionRepo.ListRevisions(submission.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.submissionRepo.CreateRevision(&article.SubmissionRevision{
+		SubmissionID:   submission.ID,
+		RevisionNumber: len(revisions) + 1,
+		VersionID:      previousVersion.ID,
+		BaseVersionID:  submission.BaseVersionID,
+		ReviewedBy:     submission.ReviewedBy,
+		ReviewNotes:    submission.ReviewNotes,
+		ReviewedAt:     submission.ReviewedAt,
+		CreatedAt:      previousVersion.CreatedAt,
+	}); err != nil {
+		return nil, 0, err
+	}
+	if err := s.versionRepo.UpdateStatus(previousVersion.ID, "superseded"); err != nil {
+		return nil, 0, err
+	}
+
+	// 3. 提交指向新修订并重新进入审核队列
+	submission.ProposedVersionID = revisedVersion.ID
+	submission.BaseVersionID = baseVersionID
+	submission.Status = "pending"
+	submission.HasConflict = false
+	submission.MergeResult = ""
+	submission.MergedAgainstVersionID = nil
+	submission.ReviewedBy = nil
+	submission.ReviewedAt = nil
+	submission.ReviewNotes = ""
+	if err := s.submissionRepo.Update(submission); err != nil {
+		return nil, 0, err
+	}
+
+	// 之前检测到的冲突随旧修订一起关闭，由提交者解决
+	if err := s.submissionRepo.ResolveConflict(submission.ID, revisedVersion.ID, userID); err != nil {
+		return nil, 0, err
+	}
+	// 对旧修订的投票作废，新修订需要重新审核
+	if err := s.submissionRepo.ClearVotes(submission.ID); err != nil {
+		return nil, 0, err
+	}
+
+	// 行内评论锚点迁移到新修订
+	s.reanchorReviewComments(submission.ID, previousVersion.Content, revisedVersion.Content, revisedVersion.ID)
+
+	return revisedVersion, len(revisions) + 2, nil
+}
+
+// WithdrawSubmission 撤回提交
+// 撤回不是审核决定：不记录审核人，提交和 proposed 版本均标记为 withdrawn
+// 权限要求：提交者本人或文章 admin；提交状态必须为 pending、conflict_detected 或 changes_requested
+func (s *ArticleService) WithdrawSubmission(submissionID uint, userID uint) (*article.ReviewSubmission, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, errors.New("提交不存在")
+	}
+
+	// 不传 userRole，Global_Admin 对文章没有管理特权
+	isSubmitter := submission.SubmittedBy == userID
+	if !isSubmitter && !s.articleRepo.CheckPermission(submission.ArticleID, userID, "", "admin") {
+		return nil, errors.New("permission denied: only the submitter or article admin can withdraw the submission")
+	}
+	if submission.Status != "pending" && submission.Status != "conflict_detected" && submission.Status != "changes_requested" {
+		return nil, errors.New("只能撤回尚未完成审核的提交")
+	}
+
+	now := time.Now()
+	submission.Status = "withdrawn"
+	submission.WithdrawnBy = &userID
+	submission.WithdrawnAt = &now
+	if err := s.submissionRepo.Update(submission); err != nil {
+		return nil, err
+	}
+
+	if err := s.versionRepo.UpdateStatus(submission.ProposedVersionID, "withdrawn"); err != nil {
+		return nil, err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[WithdrawSubmission] 提交已撤回, submissionID=%d, withdrawnBy=%d", submission.ID, userID)
+
+	return submission, nil
+}
+
 // GetArticle 获取文章详情
 func (s *ArticleService) GetArticle(articleID uint, userID uint, globalUserRole string) (map[string]interface{}, error) {
 	art, err := s.articleRepo.GetByID(articleID)
@@ -481,17 +721,21 @@ func (s *ArticleService) GetArticle(articleID uint, userID uint, globalUserRole
 	// 获取所有提交
 	allSubmissions, _ := s.submissionRepo.ListByArticle(articleID)
 
-	// 获取所有版本
-	allVersions, _ := s.versionRepo.GetVersions(articleID)
+	// 获取所有版本（只需元数据，不还原内容）
+	allVersions, _ := s.versionRepo.ListVersionMeta(articleID)
+	versionByID := make(map[uint]article.ArticleVersion, len(allVersions))
+	for _, v := range allVersions {
+		versionByID[v.ID] = v
+	}
 
-	// 构造历史记录（版本 + 提交）
+	// 构造历史记录（版本 + 提交 + 模块移动）
 	historyEntries := make([]map[string]interface{}, 0, len(allVersions)+len(allSubmissions))
 
 	// 只添加 published 和 rejected 状态的版本到历史列表
-	// pending 状态的版本属于 submission 的 proposed_version，通过 submission 条目展示
+	// pending/withdrawn/superseded 状态的版本属于 submission 的修订，通过 submission 条目展示
 	for _, v := range allVersions {
-		// 跳过 pending 状态的版本，避免与 submission 重复显示
-		if v.Status == "pending" {
+		// 跳过 pending/withdrawn/superseded 状态的版本，避免与 submission 重复显示
+		if v.Status == "pending" || v.Status == "withdrawn" || v.Status == "superseded" {
 			continue
 		}
 
@@ -537,16 +781,31 @@ func (s *ArticleService) GetArticle(articleID uint, userID uint, globalUserRole
 			"review_notes":              submission.ReviewNotes,
 			"created_at":                submission.CreatedAt,
 			"reviewed_at":               submission.ReviewedAt,
+			"withdrawn_by":              submission.WithdrawnBy,
+			"withdrawn_at":              submission.WithdrawnAt,
 		}
 
-		// 补充提交版本信息（commit_message）
-		if proposedVersion, err := s.versionRepo.GetByID(submission.ProposedVersionID); err == nil {
+		// 补充提交版本信息（commit_message，提交的版本属于同一文章，已在版本元数据中）
+		if proposedVersion, ok := versionByID[submission.ProposedVersionID]; ok {
 			entry["commit_message"] = proposedVersion.CommitMessage
 		}
 
 		historyEntries = append(historyEntries, entry)
 	}
 
+	// 添加模块移动记录
+	moves, _ := s.articleRepo.ListMoves(articleID)
+	for _, move := range moves {
+		historyEntries = append(historyEntries, map[string]interface{}{
+			"entry_type":     "move",
+			"entry_id":       move.ID,
+			"author_id":      move.MovedBy,
+			"from_module_id": move.FromModuleID,
+			"to_module_id":   move.ToModuleID,
+			"created_at":     move.CreatedAt,
+		})
+	}
+
 	// 按创建时间倒序排序
 	sort.Slice(historyEntries, func(i, j int) bool {
 		ti := historyEntries[i]["created_at"].(time.Time)
@@ -574,9 +833,6 @@ func (s *ArticleService) GetArticle(articleID uint, userID uint, globalUserRole
 		}
 	}
 
-	// 增加阅读量（使用 Redis 去重，一个用户只记一次）
-	s.articleRepo.IncrementViewCount(articleID, userID)
-
 	// 计算 is_author 和 can_delete 字段（需求 7.2, 7.3, 7.5）
 	isAuthor := art.CreatedBy == userID
 	// can_delete: Global_Admin 或 Author/Admin 可以删除
@@ -602,7 +858,7 @@ func (s *ArticleService) GetArticle(articleID uint, userID uint, globalUserRole
 		"current_version_id": art.CurrentVersionID,
 		"current_user_role":  effectiveRole,
 		"is_review_required": art.IsReviewRequired,
-		"view_count":         art.ViewCount,
+		"view_count":         art.ViewCount + s.articleRepo.PendingViewCount(art.ID),
 		"tags":               tagNames,
 		"created_by":         art.CreatedBy,
 		"created_at":         art.CreatedAt,
@@ -622,7 +878,16 @@ func (s *ArticleService) GetArticlesByModule(moduleID uint, page, pageSize int)
 		return nil, err
 	}
 
-	// 转换为响应格式
+	return map[string]interface{}{
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+		"articles":  s.buildArticleListItems(articles),
+	}, nil
+}
+
+// buildArticleListItems 转换文章列表项（附带标签和摘要）
+func (s *ArticleService) buildArticleListItems(articles []article.Article) []map[string]interface{} {
 	articleItems := make([]map[string]interface{}, len(articles))
 	for i, art := range articles {
 		// 获取每个文章的标签
@@ -654,12 +919,7 @@ func (s *ArticleService) GetArticlesByModule(moduleID uint, page, pageSize int)
 		}
 	}
 
-	return map[string]interface{}{
-		"total":     total,
-		"page":      page,
-		"page_size": pageSize,
-		"articles":  articleItems,
-	}, nil
+	return articleItems
 }
 
 // extractSummary 从HTML内容中提取纯文本摘要
@@ -713,7 +973,7 @@ func stripHTMLTags(html string) string {
 	return string(compressed)
 }
 
-// GetReviews 获取审核列表
+// GetReviews 获取审核列表（回收站中文章的提交不返回）
 func (s *ArticleService) GetReviews(status string, articleID *uint) ([]article.ReviewSubmission, error) {
 	return s.submissionRepo.GetReviews(status, articleID)
 }
@@ -781,9 +1041,10 @@ func (s *ArticleService) GetReviewDetail(submissionID uint, userID uint, globalU
 	// 5. 实时检测冲突（每次获取审核详情时重新执行三路合并）
 	var realTimeHasConflict bool
 	var realTimeMergeResult string
+	var realTimeConflicts []ConflictHunk
 
-	// 只有在 pending 或 conflict_detected 状态时才需要实时检测
-	if submission.Status == "pending" || submission.Status == "conflict_detected" {
+	// 只有在 pending、conflict_detected 或 changes_requested 状态时才需要实时检测
+	if submission.Status == "pending" || submission.Status == "conflict_detected" || submission.Status == "changes_requested" {
 		if baseVersion != nil && currentVersion != nil && proposedVersion != nil {
 			// 执行三路合并检测
 			baseContent := baseVersion.Content
@@ -792,6 +1053,7 @@ func (s *ArticleService) GetReviewDetail(submissionID uint, userID uint, globalU
 
 			mergeResult := s.mergeService.ThreeWayMerge(baseContent, theirContent, ourContent)
 			realTimeHasConflict = mergeResult.HasConflict
+			realTimeConflicts = mergeResult.Conflicts
 
 			if realTimeHasConflict {
 				// 冲突直接返回三方原始内容，由前端生成冲突标记
@@ -809,6 +1071,11 @@ func (s *ArticleService) GetReviewDetail(submissionID uint, userID uint, globalU
 		// 已审核的使用存储的结果
 		realTimeHasConflict = submission.HasConflict
 		realTimeMergeResult = submission.MergeResult
+		if realTimeHasConflict {
+			if conflict, err := s.submissionRepo.GetConflictBySubmission(submission.ID); err == nil {
+				realTimeConflicts = parseConflictDetails(conflict.ConflictDetails)
+			}
+		}
 	}
 
 	// 6. 计算用户在该文章的有效角色
@@ -840,6 +1107,54 @@ func (s *ArticleService) GetReviewDetail(submissionID uint, userID uint, globalU
 		"current_user_role":   effectiveRole, // 返回用户角色，前端用于权限判断
 	}
 
+	// 多人审核投票
+	votes, _ := s.submissionRepo.ListVotes(submission.ID)
+	voteList := make([]map[string]interface{}, 0, len(votes))
+	for _, v := range votes {
+		voteList = append(voteList, map[string]interface{}{
+			"reviewer_id": v.ReviewerID,
+			"vote":        v.Vote,
+			"notes":       v.Notes,
+			"created_at":  v.CreatedAt,
+			"updated_at":  v.UpdatedAt,
+		})
+	}
+	for k, v := range s.tallyVotes(art, votes).toMap() {
+		result[k] = v
+	}
+	result["votes"] = voteList
+
+	// 行内评论（按讨论串组织）
+	comments, _ := s.ListReviewComments(submission.ID)
+	result["comments"] = comments
+
+	// 历史修订（审核人要求修改后被替代的版本），当前修订序号 = 历史修订数 + 1
+	revisions, _ := s.submissionRepo.ListRevisions(submission.ID)
+	revisionList := make([]map[string]interface{}, 0, len(revisions))
+	revisionVersionIDs := make([]uint, len(revisions))
+	for i, rev := range revisions {
+		revisionVersionIDs[i] = rev.VersionID
+	}
+	revisionVersions, _ := s.versionRepo.GetCommitMessages(revisionVersionIDs)
+	for _, rev := range revisions {
+		entry := map[string]interface{}{
+			"revision_number": rev.RevisionNumber,
+			"version_id":      rev.VersionID,
+			"base_version_id": rev.BaseVersionID,
+			"reviewed_by":     rev.ReviewedBy,
+			"review_notes":    rev.ReviewNotes,
+			"reviewed_at":     rev.ReviewedAt,
+			"created_at":      rev.CreatedAt,
+		}
+		if v, ok := revisionVersions[rev.VersionID]; ok {
+			entry["version_number"] = v.VersionNumber
+			entry["commit_message"] = v.CommitMessage
+		}
+		revisionList = append(revisionList, entry)
+	}
+	result["revisions"] = revisionList
+	result["revision_number"] = len(revisions) + 1
+
 	// 7. 如果有冲突，返回冲突检测元数据（不包含内容，内容从版本对象获取）
 	if realTimeHasConflict {
 		// 获取版本号
@@ -856,7 +1171,9 @@ func (s *ArticleService) GetReviewDetail(submissionID uint, userID uint, globalU
 			"has_conflict":           true,
 			"base_version_number":    baseVersionNumber,
 			"current_version_number": currentVersionNumber,
+			"submitter_id":           submission.SubmittedBy,
 			"submitter_name":         "", // 由 BFF 层填充
+			"conflict_hunks":         realTimeConflicts,
 		}
 
 		// TODO: 生产环境优化 - 移除或使用结构化日志
@@ -888,14 +1205,24 @@ func (s *ArticleService) UpdateBasicInfo(articleID uint, userID uint, userRole s
 	}
 
 	// 更新标题
-	if req.Title != nil && *req.Title != "" {
+	titleChanged := false
+	if req.Title != nil && *req.Title != "" && *req.Title != art.Title {
 		art.Title = *req.Title
+		titleChanged = true
 	}
 
 	// 更新审核设置
 	if req.IsReviewRequired != nil {
 		art.IsReviewRequired = req.IsReviewRequired
 	}
+	// 更新审核通过所需人数（0 表示继承模块设置）
+	if req.RequiredApprovals != nil {
+		if *req.RequiredApprovals > 0 {
+			art.RequiredApprovals = req.RequiredApprovals
+		} else {
+			art.RequiredApprovals = nil
+		}
+	}
 
 	// 更新文章基础信息
 	art.UpdatedAt = time.Now()
@@ -903,6 +1230,13 @@ func (s *ArticleService) UpdateBasicInfo(articleID uint, userID uint, userRole s
 		return err
 	}
 
+	// 标题参与搜索，变更后需重建索引
+	if titleChanged && art.CurrentVersionID != nil {
+		if content, err := s.versionRepo.GetContent(*art.CurrentVersionID); err == nil {
+			s.reindexArticle(art, content)
+		}
+	}
+
 	// 更新标签
 	if req.Tags != nil {
 		// 移除旧标签
@@ -1085,6 +1419,110 @@ func (s *ArticleService) GetVersionDiff(versionID uint) (map[string]interface{},
 	return result, nil
 }
 
+// buildConflictData 构造返回给客户端的冲突数据（版本号、提交者和冲突区块）
+// submitter_name 由 BFF 层通过 userAggregatorService 聚合填充
+func (s *ArticleService) buildConflictData(baseVersionID uint, currentVersionID *uint, submitterID uint, conflicts []ConflictHunk) map[string]interface{} {
+	baseVersionNumber, _ := s.versionRepo.GetVersionNumber(baseVersionID)
+	currentVersionNumber := 0
+	if currentVersionID != nil {
+		if num, err := s.versionRepo.GetVersionNumber(*currentVersionID); err == nil {
+			currentVersionNumber = num
+		}
+	}
+
+	return map[string]interface{}{
+		"has_conflict":           true,
+		"base_version_number":    baseVersionNumber,
+		"current_version_number": currentVersionNumber,
+		"submitter_id":           submitterID,
+		"submitter_name":         "", // 由 BFF 层填充
+		"conflict_hunks":         conflicts,
+	}
+}
+
+// parseConflictDetails 解析 VersionConflict.ConflictDetails 中存储的冲突区块
+// 历史数据中该字段为空，解析失败时返回 nil
+func parseConflictDetails(details string) []ConflictHunk {
+	if details == "" {
+		return nil
+	}
+	var conflicts []ConflictHunk
+	if err := json.Unmarshal([]byte(details), &conflicts); err != nil {
+		return nil
+	}
+	return conflicts
+}
+
+// RevertToVersion 将文章回滚到指定的历史版本
+// 以当前线上版本为基础提交目标版本的完整内容，发布规则与 CreateSubmission 一致：
+// - 文章 admin/moderator 或文章免审核：直接发布为新版本
+// - 其他情况（普通用户、Global_Admin）：创建待审核提交
+// 回滚操作记录在新版本的提交信息中
+func (s *ArticleService) RevertToVersion(articleID uint, versionNumber int, reason string, userID uint, userRole string) (*article.ReviewSubmission, *article.ArticleVersion, error) {
+	art, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, nil, errors.New("文章不存在")
+	}
+	if art.CurrentVersionID == nil {
+		return nil, nil, errors.New("文章没有当前版本，数据可能已损坏")
+	}
+
+	targetVersion, err := s.versionRepo.GetByVersionNumber(articleID, versionNumber)
+	if err != nil {
+		return nil, nil, fmt.Errorf("版本 v%d 不存在", versionNumber)
+	}
+	// 只能回滚到曾经发布过的版本（pending/rejected 版本从未对外可见）
+	if targetVersion.Status != "published" {
+		return nil, nil, errors.New("只能回滚到已发布的版本")
+	}
+	if targetVersion.ID == *art.CurrentVersionID {
+		return nil, nil, errors.New("该版本已是当前版本，无需回滚")
+	}
+
+	commitMessage := fmt.Sprintf("回滚到版本 v%d", versionNumber)
+	if reason != "" {
+		commitMessage = fmt.Sprintf("%s：%s", commitMessage, reason)
+	}
+	if runes := []rune(commitMessage); len(runes) > 255 {
+		commitMessage = string(runes[:255])
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[RevertToVersion] articleID=%d, targetVersion=%d, currentVersionID=%d, userID=%d",
+		articleID, versionNumber, *art.CurrentVersionID, userID)
+
+	return s.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       targetVersion.Content,
+		CommitMessage: commitMessage,
+		BaseVersionID: *art.CurrentVersionID,
+	}, userID, userRole)
+}
+
+// CompareVersions 计算同一文章任意两个版本之间的差异（from -> to）
+// contextLines 为每个差异区块前后保留的未改动行数
+func (s *ArticleService) CompareVersions(articleID uint, fromVersionNumber, toVersionNumber int, contextLines int) (map[string]interface{}, error) {
+	fromVersion, err := s.versionRepo.GetByVersionNumber(articleID, fromVersionNumber)
+	if err != nil {
+		return nil, fmt.Errorf("版本 v%d 不存在", fromVersionNumber)
+	}
+
+	toVersion, err := s.versionRepo.GetByVersionNumber(articleID, toVersionNumber)
+	if err != nil {
+		return nil, fmt.Errorf("版本 v%d 不存在", toVersionNumber)
+	}
+
+	diff := s.mergeService.LineDiff(fromVersion.Content, toVersion.Content, contextLines)
+
+	return map[string]interface{}{
+		"from_version":  fromVersion,
+		"to_version":    toVersion,
+		"unified_diff":  diff.UnifiedDiff(fmt.Sprintf("v%d", fromVersionNumber), fmt.Sprintf("v%d", toVersionNumber)),
+		"hunks":         diff.Hunks,
+		"added_lines":   diff.AddedLines,
+		"removed_lines": diff.RemovedLines,
+	}, nil
+}
+
 // MergeConflictError 自定义冲突错误
 type MergeConflictError struct {
 	Message      string
@@ -1125,9 +1563,10 @@ func containsAny(s string, substrs []string) bool {
 	return false
 }
 
-// DeleteArticle 删除文章
+// DeleteArticle 删除文章（移入回收站）
 // 权限要求：Global_Admin 或 Author/Admin 可删除
-// 级联删除：versions, submissions, collaborators, favorites, tags
+// 关联数据（versions, submissions, collaborators, favorites, tags）全部保留，
+// 超过保留期限后由后台任务永久删除（见 PurgeExpiredTrash）
 func (s *ArticleService) DeleteArticle(articleID uint, userID uint, userRole string) error {
 	// 1. 获取文章信息
 	art, err := s.articleRepo.GetByID(articleID)
@@ -1157,6 +1596,12 @@ func (s *ArticleService) DeleteArticle(articleID uint, userID uint, userRole str
 		return errors.New("permission denied: only Global_Admin, Author or Admin can delete articles")
 	}
 
-	// 3. 执行级联删除（使用事务）
-	return s.articleRepo.DeleteArticleWithCascade(articleID)
+	// 3. 移入回收站（保留所有关联数据，可由模块管理员恢复）
+	if err := s.articleRepo.MoveToTrash(articleID, userID); err != nil {
+		return err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[DeleteArticle] 文章已移入回收站, articleID=%d, deletedBy=%d", articleID, userID)
+	return nil
 }
@@ -0,0 +1,149 @@
+package article
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// statsDateLayout 统计接口的日期格式
+	statsDateLayout = "2006-01-02"
+	// defaultStatsDays 未指定日期范围时统计最近多少天
+	defaultStatsDays = 30
+	// maxStatsDays 单次查询的最大天数
+	maxStatsDays = 366
+)
+
+// GetArticleStats 获取文章在日期范围内（含首尾，服务器本地日期）的每日统计
+// 每天包含阅读次数、独立访客数、发布的版本数（edits）、新建的提交数和讨论区评论数，并返回范围内发布的版本，便于对照每次修订后的变化
+// 独立访客只按天去重，汇总中不提供整个范围的独立访客数，而是返回每日独立访客数之和 visitor_days
+// startDate/endDate 格式为 YYYY-MM-DD，为空时默认最近 30 天
+// 权限要求：文章 moderator 及以上或 Global_Admin
+func (s *ArticleService) GetArticleStats(articleID uint, userID uint, userRole string, startDate, endDate string) (map[string]interface{}, error) {
+	if _, err := s.articleRepo.GetByID(articleID); err != nil {
+		return nil, errors.New("文章不存在")
+	}
+	if userRole != "admin" && !s.articleRepo.CheckPermission(articleID, userID, "", "moderator") {
+		return nil, errors.New("permission denied: only article collaborators can view stats")
+	}
+
+	start, end, err := parseStatsRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	// 版本、提交和评论按本地时间的 [start, end+1) 统计
+	from := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	to := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.Local).AddDate(0, 0, 1)
+
+	dailyStats, err := s.articleRepo.ListDailyStats(articleID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.articleRepo.ListPublishedVersionsBetween(articleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	submissionTimes, err := s.articleRepo.ListSubmissionTimes(articleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	commentTimes, err := s.articleRepo.ListCommentTimes(articleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按日期建立序列，没有数据的日期为 0
+	days := int(end.Sub(start).Hours()/24) + 1
+	series := make([]map[string]interface{}, days)
+	index := make(map[string]map[string]interface{}, days)
+	for i := range series {
+		date := start.AddDate(0, 0, i).Format(statsDateLayout)
+		series[i] = map[string]interface{}{
+			"date":            date,
+			"views":           int64(0),
+			"unique_visitors": int64(0),
+			"edits":           int64(0),
+			"submissions":     int64(0),
+			"comments":        int64(0),
+		}
+		index[date] = series[i]
+	}
+	totals := map[string]interface{}{
+		"views":        int64(0),
+		"visitor_days": int64(0),
+		"edits":        int64(0),
+		"submissions":  int64(0),
+		"comments":     int64(0),
+	}
+	add := func(date string, key string, n int64) {
+		if day, ok := index[date]; ok {
+			day[key] = day[key].(int64) + n
+			totals[key] = totals[key].(int64) + n
+		}
+	}
+	localDate := func(t time.Time) string {
+		return t.In(time.Local).Format(statsDateLayout)
+	}
+
+	for _, stat := range dailyStats {
+		date := stat.Date.Format(statsDateLayout)
+		add(date, "views", stat.Views)
+		if day, ok := index[date]; ok {
+			// 同一访客在不同日期各计一次，汇总只能是访客天数
+			day["unique_visitors"] = stat.UniqueVisitors
+			totals["visitor_days"] = totals["visitor_days"].(int64) + stat.UniqueVisitors
+		}
+	}
+	revisions := make([]map[string]interface{}, len(versions))
+	for i, v := range versions {
+		add(localDate(v.CreatedAt), "edits", 1)
+		revisions[i] = map[string]interface{}{
+			"version_id":     v.ID,
+			"version_number": v.VersionNumber,
+			"author_id":      v.AuthorID,
+			"commit_message": v.CommitMessage,
+			"created_at":     v.CreatedAt,
+		}
+	}
+	for _, t := range submissionTimes {
+		add(localDate(t), "submissions", 1)
+	}
+	for _, t := range commentTimes {
+		add(localDate(t), "comments", 1)
+	}
+
+	return map[string]interface{}{
+		"article_id": articleID,
+		"start_date": start.Format(statsDateLayout),
+		"end_date":   end.Format(statsDateLayout),
+		"series":     series,
+		"totals":     totals,
+		"revisions":  revisions,
+	}, nil
+}
+
+// parseStatsRange 解析统计日期范围，返回以 UTC 零点表示的起止日期（与 date 列一致）
+func parseStatsRange(startDate, endDate string) (time.Time, time.Time, error) {
+	end := dailyStatsDate(time.Now())
+	if endDate != "" {
+		parsed, err := time.Parse(statsDateLayout, endDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("无效的日期范围")
+		}
+		end = parsed
+	}
+
+	start := end.AddDate(0, 0, -(defaultStatsDays - 1))
+	if startDate != "" {
+		parsed, err := time.Parse(statsDateLayout, startDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("无效的日期范围")
+		}
+		start = parsed
+	}
+
+	if start.After(end) || end.Sub(start) >= maxStatsDays*24*time.Hour {
+		return time.Time{}, time.Time{}, errors.New("无效的日期范围")
+	}
+	return start, end, nil
+}
@@ -0,0 +1,239 @@
+package article
+
+import (
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TagUsage 标签及其使用次数（关联的未删除文章数）
+type TagUsage struct {
+	ID         uint
+	Name       string
+	Color      string
+	CreatedAt  time.Time
+	UsageCount int64
+}
+
+// maxTagNameLength 标签名最大长度（与 tags.name 列一致）
+const maxTagNameLength = 50
+
+// tagColorPattern 标签颜色格式：#RGB 或 #RRGGBB
+var tagColorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// ListTags 分页获取标签列表及使用次数
+// keyword 非空时按名称模糊匹配；sortBy 为 "name" 时按名称排序，否则按使用次数倒序
+func (s *ArticleService) ListTags(keyword string, sortBy string, page, pageSize int) (map[string]interface{}, error) {
+	offset := (page - 1) * pageSize
+	tags, total, err := s.tagRepo.ListWithUsage(strings.TrimSpace(keyword), sortBy, offset, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]interface{}, len(tags))
+	for i := range tags {
+		items[i] = buildTagInfo(&tags[i])
+	}
+
+	return map[string]interface{}{
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+		"tags":      items,
+	}, nil
+}
+
+// AutocompleteTags 按前缀补全标签名（常用标签优先）
+func (s *ArticleService) AutocompleteTags(prefix string, limit int) ([]map[string]interface{}, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return []map[string]interface{}{}, nil
+	}
+
+	tags, err := s.tagRepo.SearchByPrefix(prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]interface{}, len(tags))
+	for i := range tags {
+		items[i] = buildTagInfo(&tags[i])
+	}
+	return items, nil
+}
+
+// GetArticlesByTags 按标签跨模块浏览文章
+// matchAll 为 true 时文章需包含所有标签（AND），否则包含任一标签即可（OR）
+// moduleID 非 0 时只返回该模块及其子模块中的文章
+// sortBy 为 "view_count" 时按阅读量倒序，否则按更新时间倒序
+func (s *ArticleService) GetArticlesByTags(tagNames []string, matchAll bool, moduleID uint, sortBy string, page, pageSize int) (map[string]interface{}, error) {
+	seen := make(map[string]bool)
+	var names []string
+	for _, name := range tagNames {
+		name = strings.TrimSpace(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.New("请至少指定一个标签")
+	}
+
+	var moduleIDs []uint
+	if moduleID != 0 {
+		if !s.articleRepo.ModuleExists(moduleID) {
+			return nil, errors.New("模块不存在")
+		}
+		ids, err := s.articleRepo.GetModuleSubtreeIDs(moduleID)
+		if err != nil {
+			return nil, err
+		}
+		moduleIDs = ids
+	}
+
+	offset := (page - 1) * pageSize
+	articles, total, err := s.articleRepo.ListByTags(names, matchAll, moduleIDs, sortBy, offset, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+		"articles":  s.buildArticleListItems(articles),
+	}, nil
+}
+
+// RenameTag 重命名标签
+// 权限要求：Global_Admin；新名称已被其他标签使用时需改用合并
+func (s *ArticleService) RenameTag(tagID uint, name string, userRole string) (map[string]interface{}, error) {
+	if userRole != "admin" {
+		return nil, errors.New("permission denied: only global admins can manage tags")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxTagNameLength {
+		return nil, errors.New("标签名不能为空且不能超过50个字符")
+	}
+
+	tag, err := s.tagRepo.GetByID(tagID)
+	if err != nil {
+		return nil, errors.New("标签不存在")
+	}
+	if existing, err := s.tagRepo.GetByName(name); err == nil && existing.ID != tag.ID {
+		return nil, errors.New("标签名已存在，请使用合并")
+	}
+
+	if err := s.tagRepo.UpdateTag(tagID, map[string]interface{}{"name": name}); err != nil {
+		return nil, err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[RenameTag] 标签已重命名, tagID=%d, from=%s, to=%s", tagID, tag.Name, name)
+	return s.getTagInfo(tagID)
+}
+
+// UpdateTagColor 设置标签颜色
+// 权限要求：Global_Admin
+func (s *ArticleService) UpdateTagColor(tagID uint, color string, userRole string) (map[string]interface{}, error) {
+	if userRole != "admin" {
+		return nil, errors.New("permission denied: only global admins can manage tags")
+	}
+	if !tagColorPattern.MatchString(color) {
+		return nil, errors.New("无效的颜色值")
+	}
+	if _, err := s.tagRepo.GetByID(tagID); err != nil {
+		return nil, errors.New("标签不存在")
+	}
+
+	if err := s.tagRepo.UpdateTag(tagID, map[string]interface{}{"color": color}); err != nil {
+		return nil, err
+	}
+	return s.getTagInfo(tagID)
+}
+
+// MergeTags 将重复标签合并到目标标签
+// 源标签的文章改为关联目标标签，随后删除源标签
+// 权限要求：Global_Admin
+func (s *ArticleService) MergeTags(sourceTagIDs []uint, targetTagID uint, userRole string) (map[string]interface{}, error) {
+	if userRole != "admin" {
+		return nil, errors.New("permission denied: only global admins can manage tags")
+	}
+	if len(sourceTagIDs) == 0 {
+		return nil, errors.New("请指定要合并的标签")
+	}
+	if _, err := s.tagRepo.GetByID(targetTagID); err != nil {
+		return nil, errors.New("标签不存在")
+	}
+
+	seen := make(map[uint]bool)
+	var sourceIDs []uint
+	for _, id := range sourceTagIDs {
+		if id == targetTagID {
+			return nil, errors.New("不能将标签合并到自身")
+		}
+		if seen[id] {
+			continue
+		}
+		if _, err := s.tagRepo.GetByID(id); err != nil {
+			return nil, errors.New("标签不存在")
+		}
+		seen[id] = true
+		sourceIDs = append(sourceIDs, id)
+	}
+
+	if err := s.tagRepo.MergeTags(sourceIDs, targetTagID); err != nil {
+		return nil, err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[MergeTags] 标签已合并, sources=%v, target=%d", sourceIDs, targetTagID)
+	return s.getTagInfo(targetTagID)
+}
+
+// DeleteTag 删除未被使用的标签
+// 仍被文章（含回收站中的文章）使用的标签不能删除，需先合并或移除
+// 权限要求：Global_Admin
+func (s *ArticleService) DeleteTag(tagID uint, userRole string) error {
+	if userRole != "admin" {
+		return errors.New("permission denied: only global admins can manage tags")
+	}
+	if _, err := s.tagRepo.GetByID(tagID); err != nil {
+		return errors.New("标签不存在")
+	}
+	if s.tagRepo.CountArticleTags(tagID) > 0 {
+		return errors.New("标签仍被文章使用，无法删除")
+	}
+
+	if err := s.tagRepo.DeleteTag(tagID); err != nil {
+		return err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[DeleteTag] 标签已删除, tagID=%d", tagID)
+	return nil
+}
+
+// getTagInfo 获取标签详情（含使用次数）
+func (s *ArticleService) getTagInfo(tagID uint) (map[string]interface{}, error) {
+	tag, err := s.tagRepo.GetWithUsage(tagID)
+	if err != nil {
+		return nil, err
+	}
+	return buildTagInfo(tag), nil
+}
+
+// buildTagInfo 构建标签信息
+func buildTagInfo(tag *TagUsage) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          tag.ID,
+		"name":        tag.Name,
+		"color":       tag.Color,
+		"usage_count": tag.UsageCount,
+		"created_at":  tag.CreatedAt,
+	}
+}
@@ -0,0 +1,120 @@
+package article_test
+
+import (
+	"testing"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/discussion"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestGetActivityFeed_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+	articleID := fixture.TestArticle.ID
+	moduleID := fixture.TestModule.ID
+
+	// 作者直接发布新版本
+	_, published, err := service.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       "Author update",
+		CommitMessage: "author update",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+	// 普通用户提交修改，被作者驳回
+	submission, _, err := service.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       "Regular proposal",
+		CommitMessage: "proposal",
+		BaseVersionID: published.ID,
+	}, fixture.RegularUser.ID, "")
+	if err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+	if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "reject"}); err != nil {
+		t.Fatalf("ReviewSubmission failed: %v", err)
+	}
+	thread := &discussion.Discussion{ArticleID: articleID, Title: "Discussion", CreatedBy: fixture.Author.ID}
+	db.Create(thread)
+	db.Create(&discussion.DiscussionComment{DiscussionID: thread.ID, Content: "Why rejected?", CreatedBy: fixture.RegularUser.ID})
+
+	// 其他模块中的动态不应出现在模块过滤结果中
+	otherModule := testutils.CreateTestModule(db, fixture.Author.ID)
+	testutils.CreateTestArticle(db, otherModule.ID, fixture.Author.ID)
+
+	feed := func(userID, actorID uint, types []string) []map[string]interface{} {
+		t.Helper()
+		result, err := service.GetActivityFeed(userID, moduleID, actorID, types, 1, 50)
+		if err != nil {
+			t.Fatalf("GetActivityFeed failed: %v", err)
+		}
+		return result["events"].([]map[string]interface{})
+	}
+	counts := func(events []map[string]interface{}) map[string]int {
+		result := make(map[string]int)
+		for _, event := range events {
+			result[event["event_type"].(string)]++
+		}
+		return result
+	}
+
+	t.Run("all events in module", func(t *testing.T) {
+		got := counts(feed(fixture.RegularUser.ID, 0, nil))
+		expected := map[string]int{
+			"module_created":     1,
+			"article_created":    1,
+			"version_published":  1,
+			"submission_created": 1,
+			"review_decision":    1,
+			"comment_created":    1,
+		}
+		for eventType, n := range expected {
+			if got[eventType] != n {
+				t.Errorf("Expected %d %s events, got %v", n, eventType, got)
+			}
+		}
+		if len(got) != len(expected) {
+			t.Errorf("Unexpected event types: %v", got)
+		}
+	})
+
+	t.Run("guests do not see submissions and reviews", func(t *testing.T) {
+		got := counts(feed(0, 0, nil))
+		if got["submission_created"] != 0 || got["review_decision"] != 0 {
+			t.Errorf("Expected guest feed to hide submissions and reviews, got %v", got)
+		}
+		if got["article_created"] != 1 || got["version_published"] != 1 || got["comment_created"] != 1 {
+			t.Errorf("Expected guest feed to include articles, published versions and comments, got %v", got)
+		}
+	})
+
+	t.Run("filter by user and type", func(t *testing.T) {
+		got := counts(feed(fixture.Author.ID, fixture.RegularUser.ID, nil))
+		if len(got) != 2 || got["submission_created"] != 1 || got["comment_created"] != 1 {
+			t.Errorf("Expected only regular user's events, got %v", got)
+		}
+
+		events := feed(fixture.Author.ID, 0, []string{"review_decision"})
+		if len(events) != 1 || events[0]["detail"] != "rejected" || events[0]["ref_id"] != submission.ID {
+			t.Errorf("Expected the rejection decision, got %+v", events)
+		}
+		if events[0]["article_title"] != fixture.TestArticle.Title {
+			t.Errorf("Expected article title on event, got %+v", events[0])
+		}
+	})
+
+	t.Run("pagination and validation", func(t *testing.T) {
+		result, err := service.GetActivityFeed(fixture.Author.ID, moduleID, 0, nil, 2, 4)
+		if err != nil {
+			t.Fatalf("GetActivityFeed failed: %v", err)
+		}
+		if result["total"] != int64(6) || len(result["events"].([]map[string]interface{})) != 2 {
+			t.Errorf("Expected 2 of 6 events on page 2, got %+v", result)
+		}
+		if _, err := service.GetActivityFeed(fixture.Author.ID, 0, 0, []string{"unknown"}, 1, 20); err == nil {
+			t.Errorf("Expected error for invalid event type")
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package article_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/file"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestBrokenLinkReport_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+
+	existing := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID, testutils.WithTitle("Existing Article"))
+	trashed := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+	if err := service.DeleteArticle(trashed.ID, fixture.Author.ID, ""); err != nil {
+		t.Fatalf("DeleteArticle failed: %v", err)
+	}
+
+	image := &file.File{
+		FileName:   "diagram.png",
+		FileHash:   fmt.Sprintf("%064d", time.Now().UnixNano()),
+		FilePath:   "uploads/diagram.png",
+		FileSize:   1024,
+		MimeType:   "image/png",
+		Category:   "image",
+		UploadedBy: fixture.Author.ID,
+	}
+	if err := db.Create(image).Error; err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+
+	content := fmt.Sprintf("[[Existing Article]] and /articles/%d\n"+
+		"[[No Such Article]]\n"+
+		"[old](/articles/%d)\n"+
+		"![ok](/files/%d) ![ok](/uploads/diagram.png)\n"+
+		"![gone](/files/999999) ![gone](/uploads/missing.png)\n"+
+		"```\n[[Ignored In Code]]\n```",
+		existing.ID, trashed.ID, image.ID)
+	_, version, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       content,
+		CommitMessage: "add links",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+	db.Create(&file.ArticleVersionFile{VersionID: version.ID, FileID: 999998, FileType: "attachment", CreatedAt: time.Now()})
+
+	t.Run("requires module admin", func(t *testing.T) {
+		if _, err := service.GetBrokenLinkReport(fixture.TestModule.ID, fixture.RegularUser.ID, ""); err == nil {
+			t.Errorf("Expected permission error for regular user")
+		}
+	})
+
+	result, err := service.GetBrokenLinkReport(fixture.TestModule.ID, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("GetBrokenLinkReport failed: %v", err)
+	}
+	articles := result["articles"].([]map[string]interface{})
+	if len(articles) != 1 || articles[0]["article_id"] != fixture.TestArticle.ID {
+		t.Fatalf("Expected only the linking article in report, got %+v", articles)
+	}
+
+	links := articles[0]["broken_links"].([]map[string]interface{})
+	expectedLinks := []struct {
+		line     int
+		linkType string
+		reason   string
+	}{
+		{2, "title", "missing"},
+		{3, "article", "deleted"},
+	}
+	if len(links) != len(expectedLinks) {
+		t.Fatalf("Expected %d broken links, got %+v", len(expectedLinks), links)
+	}
+	for i, e := range expectedLinks {
+		if links[i]["line"] != e.line || links[i]["link_type"] != e.linkType || links[i]["reason"] != e.reason {
+			t.Errorf("broken_links[%d] = %+v, expected %+v", i, links[i], e)
+		}
+	}
+
+	files := articles[0]["broken_files"].([]map[string]interface{})
+	if len(files) != 3 {
+		t.Fatalf("Expected 3 broken file references, got %+v", files)
+	}
+	if files[0]["target"] != "999999" || files[1]["target"] != "uploads/missing.png" || files[2]["link_type"] != "attachment" {
+		t.Errorf("Unexpected broken files: %+v", files)
+	}
+	if result["broken_link_count"] != 2 || result["broken_file_count"] != 3 {
+		t.Errorf("Unexpected totals: links=%v files=%v", result["broken_link_count"], result["broken_file_count"])
+	}
+}
@@ -1,6 +1,7 @@
 package article_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -231,6 +232,22 @@ func TestConcurrentSubmissionChainConflicts_Integration(t *testing.T) {
 				t.Errorf("Expected HasConflict=true")
 			}
 
+			// 验证冲突记录中存储了冲突区块
+			var conflict article.VersionConflict
+			if err := db.Where("submission_id = ?", tt.submissionID).First(&conflict).Error; err != nil {
+				t.Fatalf("Failed to get version conflict: %v", err)
+			}
+			var hunks []articlePkg.ConflictHunk
+			if err := json.Unmarshal([]byte(conflict.ConflictDetails), &hunks); err != nil {
+				t.Fatalf("Failed to parse conflict details: %v", err)
+			}
+			if len(hunks) == 0 {
+				t.Errorf("Expected conflict details to contain at least one hunk")
+			}
+			if conflictHunks, ok := conflictData["conflict_hunks"].([]articlePkg.ConflictHunk); !ok || len(conflictHunks) != len(hunks) {
+				t.Errorf("Expected conflict_hunks in ConflictData to match stored conflict details")
+			}
+
 			// 验证current_version_id仍然是版本2（用户A的版本）
 			var art article.Article
 			if err := db.First(&art, testArticle.ID).Error; err != nil {
@@ -249,9 +266,7 @@ func TestConcurrentSubmissionChainConflicts_Integration(t *testing.T) {
 
 // TestAutomaticMergeSuccess_Integration 场景2: 无冲突自动合并（测试合并算法）
 // 描述: 用户B基于用户A已发布的版本2提交，只有B修改了（单方修改），应该能自动合并
-// 注意: 当前ThreeWayMerge算法是字符串级比较，无法识别"修改不同章节自动合并"
-//
-//	此测试验证"单方修改自动合并"场景，匹配当前算法行为
+// 注意: 此测试验证"单方修改自动合并"场景，"修改不同章节自动合并"见 merge_test.go
 func TestAutomaticMergeSuccess_Integration(t *testing.T) {
 	service, db := setupArticleService(t)
 
@@ -898,3 +913,106 @@ func TestRealTimeConflictDetection_Integration(t *testing.T) {
 		t.Errorf("Expected current_version_number in conflict_data")
 	}
 }
+
+// TestSubmitterRebaseAfterConflict_Integration 提交者自行变基解决冲突后重新进入审核
+func TestSubmitterRebaseAfterConflict_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+
+	// 1. 普通用户基于 v1 提交修改（进入审核）
+	submission, _, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       "Regular user edit",
+		CommitMessage: "regular edit",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.RegularUser.ID, "")
+	if err != nil || submission == nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	// 2. 作者直接发布 v3，修改同一行
+	_, published, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       "Author edit",
+		CommitMessage: "author edit",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.Author.ID, "")
+	if err != nil || published == nil {
+		t.Fatalf("Failed to publish author edit: %v", err)
+	}
+
+	// 3. 审核时检测到冲突
+	if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "approve"}); err == nil {
+		t.Fatalf("Expected merge conflict")
+	}
+
+	rebaseReq := dto.UpdateSubmissionRequest{
+		Content:       "Author edit\nRegular user addition",
+		CommitMessage: "rebased edit",
+		BaseVersionID: published.ID,
+	}
+
+	t.Run("only the submitter can update", func(t *testing.T) {
+		if _, err := service.UpdateSubmission(submission.ID, rebaseReq, fixture.Author.ID); err == nil {
+			t.Errorf("Expected permission error for non-submitter")
+		}
+	})
+
+	t.Run("stale base is rejected", func(t *testing.T) {
+		staleReq := rebaseReq
+		staleReq.BaseVersionID = fixture.BaseVersion.ID
+		if _, err := service.UpdateSubmission(submission.ID, staleReq, fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected error when rebasing onto a non-current version")
+		}
+	})
+
+	// 4. 提交者变基
+	updated, err := service.UpdateSubmission(submission.ID, rebaseReq, fixture.RegularUser.ID)
+	if err != nil {
+		t.Fatalf("UpdateSubmission failed: %v", err)
+	}
+	if updated.Status != "pending" || updated.HasConflict || updated.BaseVersionID != published.ID {
+		t.Errorf("Expected pending submission based on v%d, got %+v", published.VersionNumber, updated)
+	}
+
+	var conflict article.VersionConflict
+	fixture.DB.Where("submission_id = ?", submission.ID).First(&conflict)
+	if conflict.Status != "resolved" || conflict.ResolvedBy == nil || *conflict.ResolvedBy != fixture.RegularUser.ID {
+		t.Errorf("Expected conflict resolved by submitter, got %+v", conflict)
+	}
+
+	var proposed article.ArticleVersion
+	fixture.DB.First(&proposed, updated.ProposedVersionID)
+	if proposed.BaseVersionID == nil || *proposed.BaseVersionID != published.ID || proposed.CommitMessage != "rebased edit" {
+		t.Errorf("Expected proposed version rebased onto v%d, got %+v", published.VersionNumber, proposed)
+	}
+
+	// 变基推送新修订，审核人看到过的原内容保留在提交历史中
+	if updated.ProposedVersionID == submission.ProposedVersionID {
+		t.Errorf("Expected rebase to create a new proposed version")
+	}
+	var original article.ArticleVersion
+	fixture.DB.First(&original, submission.ProposedVersionID)
+	if original.Status != "superseded" {
+		t.Errorf("Expected original revision kept as superseded, got status=%s", original.Status)
+	}
+	var revisions []article.SubmissionRevision
+	fixture.DB.Where("submission_id = ?", submission.ID).Find(&revisions)
+	if len(revisions) != 1 || revisions[0].VersionID != submission.ProposedVersionID {
+		t.Errorf("Expected one recorded revision for the original version, got %+v", revisions)
+	}
+
+	// 5. 重新审核可直接合并
+	if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "approve"}); err != nil {
+		t.Fatalf("Expected rebased submission to merge cleanly: %v", err)
+	}
+	var art article.Article
+	fixture.DB.First(&art, fixture.TestArticle.ID)
+	if art.CurrentVersionID == nil || *art.CurrentVersionID != updated.ProposedVersionID {
+		t.Errorf("Expected rebased version to be published")
+	}
+
+	t.Run("merged submission cannot be updated", func(t *testing.T) {
+		if _, err := service.UpdateSubmission(submission.ID, rebaseReq, fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected error when updating a merged submission")
+		}
+	})
+}
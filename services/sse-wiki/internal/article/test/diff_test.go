@@ -0,0 +1,85 @@
+package article_test
+
+import (
+	"testing"
+
+	articlePkg "terminal-terrace/sse-wiki/internal/article"
+)
+
+// TestLineDiff 行级差异计算单元测试，不依赖数据库
+func TestLineDiff(t *testing.T) {
+	mergeService := articlePkg.NewMergeService()
+
+	t.Run("identical content has no hunks", func(t *testing.T) {
+		result := mergeService.LineDiff(BaseContentGoTutorial, BaseContentGoTutorial, 3)
+		if len(result.Hunks) != 0 || result.AddedLines != 0 || result.RemovedLines != 0 {
+			t.Errorf("Expected empty diff, got %+v", result)
+		}
+		if unified := result.UnifiedDiff("v1", "v1"); unified != "" {
+			t.Errorf("Expected empty unified diff, got %q", unified)
+		}
+	})
+
+	t.Run("single line modification", func(t *testing.T) {
+		from := "a\nb\nc\nd\ne\nf\ng\nh\n"
+		to := "a\nb\nc\nd\nE\nf\ng\nh\n"
+
+		result := mergeService.LineDiff(from, to, 3)
+		if result.AddedLines != 1 || result.RemovedLines != 1 {
+			t.Errorf("Expected 1 added and 1 removed line, got +%d -%d", result.AddedLines, result.RemovedLines)
+		}
+		if len(result.Hunks) != 1 {
+			t.Fatalf("Expected 1 hunk, got %d", len(result.Hunks))
+		}
+
+		expectedUnified := "--- v1\n+++ v2\n" +
+			"@@ -2,7 +2,7 @@\n" +
+			" b\n c\n d\n-e\n+E\n f\n g\n h\n"
+		if unified := result.UnifiedDiff("v1", "v2"); unified != expectedUnified {
+			t.Errorf("Unexpected unified diff:\nExpected:\n%s\nGot:\n%s", expectedUnified, unified)
+		}
+
+		hunk := result.Hunks[0]
+		removed := hunk.Lines[3]
+		added := hunk.Lines[4]
+		if removed.Type != "removed" || removed.Content != "e" || removed.OldLine != 5 || removed.NewLine != 0 {
+			t.Errorf("Unexpected removed line: %+v", removed)
+		}
+		if added.Type != "added" || added.Content != "E" || added.OldLine != 0 || added.NewLine != 5 {
+			t.Errorf("Unexpected added line: %+v", added)
+		}
+	})
+
+	t.Run("distant changes produce separate hunks", func(t *testing.T) {
+		from := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+		to := "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n"
+
+		result := mergeService.LineDiff(from, to, 2)
+		if len(result.Hunks) != 2 {
+			t.Fatalf("Expected 2 hunks, got %d", len(result.Hunks))
+		}
+		if result.AddedLines != 2 || result.RemovedLines != 1 {
+			t.Errorf("Expected +2 -1, got +%d -%d", result.AddedLines, result.RemovedLines)
+		}
+		second := result.Hunks[1]
+		if second.OldStart != 11 || second.OldCount != 2 || second.NewStart != 11 || second.NewCount != 3 {
+			t.Errorf("Unexpected second hunk header: %+v", second)
+		}
+	})
+
+	t.Run("insertion into empty content", func(t *testing.T) {
+		result := mergeService.LineDiff("", "x\ny\n", 3)
+		expectedUnified := "--- v1\n+++ v2\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+		if unified := result.UnifiedDiff("v1", "v2"); unified != expectedUnified {
+			t.Errorf("Unexpected unified diff:\nExpected:\n%s\nGot:\n%s", expectedUnified, unified)
+		}
+	})
+
+	t.Run("missing trailing newline is marked", func(t *testing.T) {
+		result := mergeService.LineDiff("a\nb", "a\nc", 3)
+		expectedUnified := "--- v1\n+++ v2\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n"
+		if unified := result.UnifiedDiff("v1", "v2"); unified != expectedUnified {
+			t.Errorf("Unexpected unified diff:\nExpected:\n%s\nGot:\n%s", expectedUnified, unified)
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package article_test
+
+import (
+	"testing"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+func TestDrafts_Integration(t *testing.T) {
+	t.Run("save, update and get draft", func(t *testing.T) {
+		fixture := createArticleFixture(t)
+		service := fixture.Service
+
+		draft, err := service.SaveDraft(fixture.TestArticle.ID, fixture.RegularUser.ID, dto.SaveDraftRequest{
+			Content: "Initial content\nwork in progress",
+		})
+		if err != nil {
+			t.Fatalf("SaveDraft failed: %v", err)
+		}
+		if draft["base_version_id"] != fixture.BaseVersion.ID {
+			t.Errorf("Expected draft to start from current version %d, got %v", fixture.BaseVersion.ID, draft["base_version_id"])
+		}
+
+		// 自动保存：再次保存只更新内容，基础版本保持不变
+		if _, err := service.SaveDraft(fixture.TestArticle.ID, fixture.RegularUser.ID, dto.SaveDraftRequest{
+			Content:       "Initial content\nwork in progress\nmore",
+			CommitMessage: "expand article",
+		}); err != nil {
+			t.Fatalf("SaveDraft update failed: %v", err)
+		}
+
+		var count int64
+		fixture.DB.Model(&article.ArticleDraft{}).
+			Where("article_id = ? AND user_id = ?", fixture.TestArticle.ID, fixture.RegularUser.ID).
+			Count(&count)
+		if count != 1 {
+			t.Errorf("Expected a single draft per user and article, got %d", count)
+		}
+
+		got, err := service.GetDraft(fixture.TestArticle.ID, fixture.RegularUser.ID)
+		if err != nil {
+			t.Fatalf("GetDraft failed: %v", err)
+		}
+		if got["content"] != "Initial content\nwork in progress\nmore" || got["commit_message"] != "expand article" {
+			t.Errorf("Unexpected draft: %+v", got)
+		}
+		if got["base_version_number"] != 1 || got["is_outdated"] != false {
+			t.Errorf("Expected draft based on v1 and up to date, got %+v", got)
+		}
+
+		// 草稿是个人的，其他用户看不到
+		if _, err := service.GetDraft(fixture.TestArticle.ID, fixture.Author.ID); err == nil {
+			t.Errorf("Expected other users to have no draft")
+		}
+	})
+
+	t.Run("drafts never appear in version history", func(t *testing.T) {
+		fixture := createArticleFixture(t)
+		service := fixture.Service
+
+		if _, err := service.SaveDraft(fixture.TestArticle.ID, fixture.Author.ID, dto.SaveDraftRequest{
+			Content: "draft only",
+		}); err != nil {
+			t.Fatalf("SaveDraft failed: %v", err)
+		}
+
+		versions, err := service.GetVersions(fixture.TestArticle.ID)
+		if err != nil {
+			t.Fatalf("GetVersions failed: %v", err)
+		}
+		if len(versions) != 1 {
+			t.Errorf("Expected drafts to create no versions, got %d versions", len(versions))
+		}
+
+		var submissions int64
+		fixture.DB.Model(&article.ReviewSubmission{}).Where("article_id = ?", fixture.TestArticle.ID).Count(&submissions)
+		if submissions != 0 {
+			t.Errorf("Expected drafts to create no submissions, got %d", submissions)
+		}
+	})
+
+	t.Run("submit draft goes through review and removes draft", func(t *testing.T) {
+		fixture := createArticleFixture(t)
+		service := fixture.Service
+
+		if _, err := service.SaveDraft(fixture.TestArticle.ID, fixture.RegularUser.ID, dto.SaveDraftRequest{
+			Content:       "Initial content\nproposed change",
+			CommitMessage: "propose change",
+		}); err != nil {
+			t.Fatalf("SaveDraft failed: %v", err)
+		}
+
+		submission, version, err := service.SubmitDraft(fixture.TestArticle.ID, "", fixture.RegularUser.ID, "")
+		if err != nil {
+			t.Fatalf("SubmitDraft failed: %v", err)
+		}
+		if version != nil || submission == nil || submission.Status != "pending" {
+			t.Fatalf("Expected pending submission, got submission=%+v version=%+v", submission, version)
+		}
+		if submission.BaseVersionID != fixture.BaseVersion.ID {
+			t.Errorf("Expected submission base %d, got %d", fixture.BaseVersion.ID, submission.BaseVersionID)
+		}
+
+		if _, err := service.GetDraft(fixture.TestArticle.ID, fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected draft to be removed after submission")
+		}
+	})
+
+	t.Run("outdated draft is reported and discard works", func(t *testing.T) {
+		fixture := createArticleFixture(t)
+		service := fixture.Service
+
+		if _, err := service.SaveDraft(fixture.TestArticle.ID, fixture.RegularUser.ID, dto.SaveDraftRequest{
+			Content: "Initial content\nmy edit",
+		}); err != nil {
+			t.Fatalf("SaveDraft failed: %v", err)
+		}
+
+		// 作者发布新版本后，草稿落后于当前版本
+		if _, _, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+			Content:       "Initial content\nauthor edit",
+			CommitMessage: "author edit",
+			BaseVersionID: fixture.BaseVersion.ID,
+		}, fixture.Author.ID, ""); err != nil {
+			t.Fatalf("CreateSubmission failed: %v", err)
+		}
+
+		drafts, err := service.ListMyDrafts(fixture.RegularUser.ID)
+		if err != nil {
+			t.Fatalf("ListMyDrafts failed: %v", err)
+		}
+		if len(drafts) != 1 || drafts[0]["is_outdated"] != true {
+			t.Fatalf("Expected one outdated draft, got %+v", drafts)
+		}
+		if drafts[0]["article_title"] != fixture.TestArticle.Title {
+			t.Errorf("Expected article title in draft list, got %v", drafts[0]["article_title"])
+		}
+
+		if err := service.DiscardDraft(fixture.TestArticle.ID, fixture.RegularUser.ID); err != nil {
+			t.Fatalf("DiscardDraft failed: %v", err)
+		}
+		if err := service.DiscardDraft(fixture.TestArticle.ID, fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected error when discarding a non-existent draft")
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package article_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestGetFeed_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+	articleID := fixture.TestArticle.ID
+
+	child := testutils.CreateTestModule(db, fixture.Author.ID, testutils.WithParentID(fixture.TestModule.ID))
+	childArticle := testutils.CreateTestArticle(db, child.ID, fixture.Author.ID)
+
+	// 作者直接发布新版本，提交信息包含需要转义的字符
+	if _, _, err := service.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       "Updated content",
+		CommitMessage: "Fix <table> & links",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.Author.ID, ""); err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+	// 待审核的提交不应出现在订阅中
+	if _, _, err := service.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       "Pending content",
+		CommitMessage: "pending change",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.RegularUser.ID, ""); err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+
+	t.Run("article revision feed in atom", func(t *testing.T) {
+		result, err := service.GetFeed("article", articleID, "", 50)
+		if err != nil {
+			t.Fatalf("GetFeed failed: %v", err)
+		}
+		if !strings.HasPrefix(result["content_type"].(string), "application/atom+xml") {
+			t.Errorf("Unexpected content type %v", result["content_type"])
+		}
+
+		var feed struct {
+			Title   string `xml:"title"`
+			Entries []struct {
+				Title  string `xml:"title"`
+				Author string `xml:"author>name"`
+			} `xml:"entry"`
+		}
+		if err := xml.Unmarshal([]byte(result["content"].(string)), &feed); err != nil {
+			t.Fatalf("Invalid Atom document: %v", err)
+		}
+		if len(feed.Entries) != 2 || feed.Entries[0].Title != "v2: Fix <table> & links" {
+			t.Fatalf("Expected 2 published revisions newest first, got %+v", feed.Entries)
+		}
+		if feed.Entries[0].Author != fixture.Author.Username {
+			t.Errorf("Expected author name %q, got %q", fixture.Author.Username, feed.Entries[0].Author)
+		}
+	})
+
+	t.Run("module subtree feed in rss", func(t *testing.T) {
+		// 子模块中的文章也要出现
+		childVersion := *fixture.BaseVersion
+		childVersion.ID = 0
+		childVersion.ArticleID = childArticle.ID
+		db.Create(&childVersion)
+
+		result, err := service.GetFeed("module", fixture.TestModule.ID, "rss", 50)
+		if err != nil {
+			t.Fatalf("GetFeed failed: %v", err)
+		}
+		var rss struct {
+			Version string `xml:"version,attr"`
+			Items   []struct {
+				Title string `xml:"title"`
+				GUID  string `xml:"guid"`
+			} `xml:"channel>item"`
+		}
+		if err := xml.Unmarshal([]byte(result["content"].(string)), &rss); err != nil {
+			t.Fatalf("Invalid RSS document: %v", err)
+		}
+		if rss.Version != "2.0" || len(rss.Items) != 3 {
+			t.Errorf("Expected 3 items in RSS 2.0 feed, got %+v", rss)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		if _, err := service.GetFeed("article", articleID, "json", 50); err == nil {
+			t.Errorf("Expected error for invalid format")
+		}
+		if _, err := service.GetFeed("user", articleID, "atom", 50); err == nil {
+			t.Errorf("Expected error for invalid feed type")
+		}
+		if _, err := service.GetFeed("module", 999999, "atom", 50); err == nil {
+			t.Errorf("Expected error for missing module")
+		}
+	})
+}
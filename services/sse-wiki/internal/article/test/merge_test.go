@@ -0,0 +1,191 @@
+package article_test
+
+import (
+	"testing"
+
+	articlePkg "terminal-terrace/sse-wiki/internal/article"
+)
+
+// TestThreeWayMerge 行级3路合并（diff3语义）单元测试，不依赖数据库
+func TestThreeWayMerge(t *testing.T) {
+	mergeService := articlePkg.NewMergeService()
+
+	tests := []struct {
+		name           string
+		base           string
+		theirs         string
+		ours           string
+		expectConflict bool
+		expectedMerged string
+	}{
+		{
+			name:           "no changes",
+			base:           BaseContentGoTutorial,
+			theirs:         BaseContentGoTutorial,
+			ours:           BaseContentGoTutorial,
+			expectedMerged: BaseContentGoTutorial,
+		},
+		{
+			name:           "only theirs changed",
+			base:           BaseContentGoTutorial,
+			theirs:         UserAContentGoTutorial,
+			ours:           BaseContentGoTutorial,
+			expectedMerged: UserAContentGoTutorial,
+		},
+		{
+			name:           "only ours changed",
+			base:           BaseContentGoTutorial,
+			theirs:         BaseContentGoTutorial,
+			ours:           UserAContentGoTutorial,
+			expectedMerged: UserAContentGoTutorial,
+		},
+		{
+			name:           "identical changes on both sides",
+			base:           BaseContentSimple,
+			theirs:         UserAContentSimple,
+			ours:           UserAContentSimple,
+			expectedMerged: UserAContentSimple,
+		},
+		{
+			name: "non-overlapping changes in different sections are merged",
+			base: BaseContentGoTutorial,
+			// 第一章修改（用户A）
+			theirs: UserAContentGoTutorial,
+			// 第二章修改（基于原始版本）
+			ours: `<h1>Go语言教程</h1>
+<section>
+  <h2>第一章：基础语法</h2>
+  <p>Go语言是Google开发的编程语言。</p>
+</section>
+<section>
+  <h2>第二章：并发编程</h2>
+  <p>Go语言支持goroutine和channel。</p>
+</section>`,
+			expectedMerged: UserBContentGoTutorial,
+		},
+		{
+			name:           "both sides edit the same line",
+			base:           BaseContentSSEWiki,
+			theirs:         UserDContentSSEWiki,
+			ours:           UserAContentSSEWiki,
+			expectConflict: true,
+		},
+		{
+			name:           "adjacent edits without an unchanged line between conflict",
+			base:           BaseContentSSEWiki,
+			theirs:         UserBContentSSEWiki,
+			ours:           UserAContentSSEWiki,
+			expectConflict: true,
+		},
+		{
+			name:           "insertions at different positions are merged",
+			base:           "a\nb\nc\nd\ne\n",
+			theirs:         "intro\na\nb\nc\nd\ne\n",
+			ours:           "a\nb\nc\nd\ne\noutro\n",
+			expectedMerged: "intro\na\nb\nc\nd\ne\noutro\n",
+		},
+		{
+			name:           "deletion on one side and edit elsewhere",
+			base:           "a\nb\nc\nd\ne\n",
+			theirs:         "a\nc\nd\ne\n",
+			ours:           "a\nb\nc\nd\nE\n",
+			expectedMerged: "a\nc\nd\nE\n",
+		},
+		{
+			name:           "deletion conflicts with edit of the same line",
+			base:           "a\nb\nc\n",
+			theirs:         "a\nc\n",
+			ours:           "a\nB\nc\n",
+			expectConflict: true,
+		},
+		{
+			name:           "different insertions at the same position conflict",
+			base:           "a\nb\n",
+			theirs:         "a\nx\nb\n",
+			ours:           "a\ny\nb\n",
+			expectConflict: true,
+		},
+		{
+			name:           "empty base",
+			base:           "",
+			theirs:         "new content\n",
+			ours:           "",
+			expectedMerged: "new content\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := mergeService.ThreeWayMerge(tt.base, tt.theirs, tt.ours)
+
+			if result.HasConflict != tt.expectConflict {
+				t.Fatalf("Expected HasConflict=%v, got %v", tt.expectConflict, result.HasConflict)
+			}
+			if tt.expectConflict {
+				if result.MergedContent != "" {
+					t.Errorf("Expected empty merged content on conflict, got %q", result.MergedContent)
+				}
+				return
+			}
+			if result.MergedContent != tt.expectedMerged {
+				t.Errorf("Unexpected merged content:\nExpected:\n%s\n\nGot:\n%s", tt.expectedMerged, result.MergedContent)
+			}
+		})
+	}
+}
+
+// TestThreeWayMerge_ConflictHunks 验证冲突区块的行号和三方内容
+func TestThreeWayMerge_ConflictHunks(t *testing.T) {
+	mergeService := articlePkg.NewMergeService()
+
+	base := "title\nintro\nbody\nfooter\nend\n"
+	theirs := "title\nintro by A\nbody\nfooter by A\nend\n"
+	ours := "title\nintro by B\nbody\nfooter\nend\nappendix\n"
+
+	result := mergeService.ThreeWayMerge(base, theirs, ours)
+	if !result.HasConflict {
+		t.Fatalf("Expected conflict")
+	}
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("Expected 1 conflict hunk, got %d: %+v", len(result.Conflicts), result.Conflicts)
+	}
+
+	expected := articlePkg.ConflictHunk{
+		BaseStart:    2,
+		BaseCount:    1,
+		TheirStart:   2,
+		TheirCount:   1,
+		OurStart:     2,
+		OurCount:     1,
+		BaseContent:  "intro\n",
+		TheirContent: "intro by A\n",
+		OurContent:   "intro by B\n",
+	}
+	if result.Conflicts[0] != expected {
+		t.Errorf("Unexpected conflict hunk:\nExpected: %+v\nGot:      %+v", expected, result.Conflicts[0])
+	}
+
+	t.Run("multiple hunks", func(t *testing.T) {
+		theirs := "title\nintro by A\nbody\nfooter by A\nend\n"
+		ours := "title\nintro by B\nbody\nfooter by B\nend\n"
+
+		result := mergeService.ThreeWayMerge(base, theirs, ours)
+		if len(result.Conflicts) != 2 {
+			t.Fatalf("Expected 2 conflict hunks, got %d", len(result.Conflicts))
+		}
+		if result.Conflicts[1].BaseStart != 4 || result.Conflicts[1].OurContent != "footer by B\n" {
+			t.Errorf("Unexpected second hunk: %+v", result.Conflicts[1])
+		}
+	})
+
+	t.Run("insertion hunk has zero base count", func(t *testing.T) {
+		result := mergeService.ThreeWayMerge("a\nb\n", "a\nx\nb\n", "a\ny\nz\nb\n")
+		if len(result.Conflicts) != 1 {
+			t.Fatalf("Expected 1 conflict hunk, got %d", len(result.Conflicts))
+		}
+		hunk := result.Conflicts[0]
+		if hunk.BaseStart != 2 || hunk.BaseCount != 0 || hunk.TheirCount != 1 || hunk.OurCount != 2 {
+			t.Errorf("Unexpected insertion hunk: %+v", hunk)
+		}
+	})
+}
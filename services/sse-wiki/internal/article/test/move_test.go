@@ -0,0 +1,81 @@
+package article_test
+
+import (
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/model/module"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestMoveArticle_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+
+	// 目标模块属于其他用户，作者在目标模块没有权限
+	otherOwner := testutils.CreateTestUser(db)
+	targetModule := testutils.CreateTestModule(db, otherOwner.ID)
+	sourceModuleID := fixture.TestModule.ID
+
+	t.Run("requires permission on target module", func(t *testing.T) {
+		if _, err := service.MoveArticle(fixture.TestArticle.ID, targetModule.ID, fixture.Author.ID, ""); err == nil {
+			t.Errorf("Expected permission error without target module permission")
+		}
+	})
+
+	t.Run("requires permission on source module", func(t *testing.T) {
+		if _, err := service.MoveArticle(fixture.TestArticle.ID, targetModule.ID, otherOwner.ID, ""); err == nil {
+			t.Errorf("Expected permission error without source module permission")
+		}
+	})
+
+	t.Run("rejects missing or same module", func(t *testing.T) {
+		if _, err := service.MoveArticle(fixture.TestArticle.ID, 999999, fixture.Author.ID, ""); err == nil {
+			t.Errorf("Expected error for missing target module")
+		}
+		if _, err := service.MoveArticle(fixture.TestArticle.ID, sourceModuleID, fixture.Author.ID, ""); err == nil {
+			t.Errorf("Expected error when moving into the current module")
+		}
+	})
+
+	// 授予作者目标模块的 moderator 权限后可以移动
+	db.Create(&module.ModuleModerator{
+		ModuleID:  targetModule.ID,
+		UserID:    fixture.Author.ID,
+		Role:      "moderator",
+		CreatedAt: time.Now(),
+	})
+
+	move, err := service.MoveArticle(fixture.TestArticle.ID, targetModule.ID, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("MoveArticle failed: %v", err)
+	}
+	if move.FromModuleID != sourceModuleID || move.ToModuleID != targetModule.ID || move.MovedBy != fixture.Author.ID {
+		t.Errorf("Unexpected move record: %+v", move)
+	}
+
+	var moved article.Article
+	db.First(&moved, fixture.TestArticle.ID)
+	if moved.ModuleID != targetModule.ID {
+		t.Errorf("Expected article module %d, got %d", targetModule.ID, moved.ModuleID)
+	}
+
+	t.Run("old module path still resolves", func(t *testing.T) {
+		moduleID, wasMoved, err := service.ResolveArticleModule(sourceModuleID, fixture.TestArticle.ID)
+		if err != nil || !wasMoved || moduleID != targetModule.ID {
+			t.Errorf("Expected old path to resolve to module %d, got %d (moved=%v, err=%v)", targetModule.ID, moduleID, wasMoved, err)
+		}
+
+		moduleID, wasMoved, err = service.ResolveArticleModule(targetModule.ID, fixture.TestArticle.ID)
+		if err != nil || wasMoved || moduleID != targetModule.ID {
+			t.Errorf("Expected current path to resolve without redirect, got %d (moved=%v, err=%v)", moduleID, wasMoved, err)
+		}
+
+		unrelated := testutils.CreateTestModule(db, otherOwner.ID)
+		if _, _, err := service.ResolveArticleModule(unrelated.ID, fixture.TestArticle.ID); err == nil {
+			t.Errorf("Expected unrelated module path not to resolve")
+		}
+	})
+}
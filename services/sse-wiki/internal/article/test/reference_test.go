@@ -0,0 +1,135 @@
+package article_test
+
+import (
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestArticleReferences_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+
+	// A 需要先读 B，B 需要先读 C
+	articleA := fixture.TestArticle
+	articleB := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+	articleC := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+	for _, id := range []uint{articleB.ID, articleC.ID} {
+		db.Create(&article.ArticleCollaborator{ArticleID: id, UserID: fixture.Author.ID, Role: "admin", CreatedAt: time.Now()})
+	}
+
+	t.Run("validates input and permissions", func(t *testing.T) {
+		if _, err := service.AddReference(articleA.ID, articleB.ID, "prerequisite", fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected permission error for regular user")
+		}
+		if _, err := service.AddReference(articleA.ID, articleB.ID, "unknown", fixture.Author.ID); err == nil {
+			t.Errorf("Expected error for invalid reference type")
+		}
+		if _, err := service.AddReference(articleA.ID, articleA.ID, "related", fixture.Author.ID); err == nil {
+			t.Errorf("Expected error for self reference")
+		}
+		if _, err := service.AddReference(articleA.ID, 999999, "related", fixture.Author.ID); err == nil {
+			t.Errorf("Expected error for missing target article")
+		}
+	})
+
+	for _, ref := range []struct {
+		from, to uint
+		refType  string
+	}{
+		{articleA.ID, articleB.ID, "prerequisite"},
+		{articleB.ID, articleC.ID, "prerequisite"},
+		{articleA.ID, articleC.ID, "related"},
+	} {
+		if _, err := service.AddReference(ref.from, ref.to, ref.refType, fixture.Author.ID); err != nil {
+			t.Fatalf("AddReference(%d -> %d) failed: %v", ref.from, ref.to, err)
+		}
+	}
+
+	t.Run("rejects duplicates and prerequisite cycles", func(t *testing.T) {
+		if _, err := service.AddReference(articleA.ID, articleB.ID, "related", fixture.Author.ID); err == nil {
+			t.Errorf("Expected error for duplicate reference")
+		}
+		if _, err := service.AddReference(articleC.ID, articleA.ID, "prerequisite", fixture.Author.ID); err == nil {
+			t.Errorf("Expected error for prerequisite cycle")
+		}
+		// 非 prerequisite 引用不参与循环检测
+		if _, err := service.AddReference(articleC.ID, articleA.ID, "extends", fixture.Author.ID); err != nil {
+			t.Errorf("Expected extends reference to be allowed: %v", err)
+		}
+	})
+
+	t.Run("lists references and backlinks", func(t *testing.T) {
+		result, err := service.GetArticleReferences(articleC.ID, "")
+		if err != nil {
+			t.Fatalf("GetArticleReferences failed: %v", err)
+		}
+		if refs := result["references"].([]map[string]interface{}); len(refs) != 1 || refs[0]["article_id"] != articleA.ID {
+			t.Errorf("Expected C to reference A, got %+v", refs)
+		}
+		if backlinks := result["backlinks"].([]map[string]interface{}); len(backlinks) != 2 {
+			t.Errorf("Expected 2 backlinks to C, got %d", len(backlinks))
+		}
+
+		result, err = service.GetArticleReferences(articleC.ID, "prerequisite")
+		if err != nil {
+			t.Fatalf("GetArticleReferences failed: %v", err)
+		}
+		backlinks := result["backlinks"].([]map[string]interface{})
+		if len(backlinks) != 1 || backlinks[0]["article_id"] != articleB.ID {
+			t.Errorf("Expected only B as prerequisite backlink, got %+v", backlinks)
+		}
+	})
+
+	t.Run("learning path lists prerequisites first", func(t *testing.T) {
+		result, err := service.GetLearningPath(articleA.ID)
+		if err != nil {
+			t.Fatalf("GetLearningPath failed: %v", err)
+		}
+		path := result["path"].([]map[string]interface{})
+		expected := []uint{articleC.ID, articleB.ID, articleA.ID}
+		if len(path) != len(expected) {
+			t.Fatalf("Expected %d articles in path, got %d", len(expected), len(path))
+		}
+		for i, id := range expected {
+			if path[i]["article_id"] != id || path[i]["level"] != i {
+				t.Errorf("path[%d] = %+v, expected article %d at level %d", i, path[i], id, i)
+			}
+		}
+		if result["has_cycle"] != false {
+			t.Errorf("Expected no cycle")
+		}
+	})
+
+	t.Run("learning path detects cycles", func(t *testing.T) {
+		// 绕过服务层直接写入循环
+		db.Where("from_article_id = ? AND to_article_id = ?", articleC.ID, articleA.ID).Delete(&article.ArticleReference{})
+		db.Create(&article.ArticleReference{FromArticleID: articleC.ID, ToArticleID: articleA.ID, ReferenceType: "prerequisite", CreatedAt: time.Now()})
+
+		result, err := service.GetLearningPath(articleA.ID)
+		if err != nil {
+			t.Fatalf("GetLearningPath failed: %v", err)
+		}
+		if result["has_cycle"] != true {
+			t.Errorf("Expected cycle to be detected")
+		}
+		if path := result["path"].([]map[string]interface{}); len(path) != 3 {
+			t.Errorf("Expected each article once in path, got %d", len(path))
+		}
+	})
+
+	t.Run("remove reference", func(t *testing.T) {
+		if err := service.RemoveReference(articleA.ID, articleC.ID, fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected permission error for regular user")
+		}
+		if err := service.RemoveReference(articleA.ID, articleC.ID, fixture.Author.ID); err != nil {
+			t.Fatalf("RemoveReference failed: %v", err)
+		}
+		if err := service.RemoveReference(articleA.ID, articleC.ID, fixture.Author.ID); err == nil {
+			t.Errorf("Expected error when removing a missing reference")
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package article_test
+
+import (
+	"testing"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+)
+
+func TestReviewComments_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+
+	submission, _, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       "Initial content\nline two\nline three",
+		CommitMessage: "add lines",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.RegularUser.ID, "")
+	if err != nil || submission == nil {
+		t.Fatalf("Failed to create pending submission: %v", err)
+	}
+
+	// 1. 审核人在第2行和第3行各发起一个讨论
+	kept, err := service.AddReviewComment(submission.ID, fixture.Author.ID, dto.ReviewCommentRequest{
+		Content: "这一行需要补充说明", StartLine: 2, EndLine: 2,
+	})
+	if err != nil {
+		t.Fatalf("AddReviewComment failed: %v", err)
+	}
+	removed, err := service.AddReviewComment(submission.ID, fixture.Author.ID, dto.ReviewCommentRequest{
+		Content: "这一行有误", StartLine: 3, EndLine: 3,
+	})
+	if err != nil {
+		t.Fatalf("AddReviewComment failed: %v", err)
+	}
+
+	// 2. 提交者回复，回复的回复仍挂在根评论下
+	reply, err := service.AddReviewComment(submission.ID, fixture.RegularUser.ID, dto.ReviewCommentRequest{
+		Content: "好的", ParentID: kept.ID,
+	})
+	if err != nil {
+		t.Fatalf("Reply failed: %v", err)
+	}
+	nested, err := service.AddReviewComment(submission.ID, fixture.Author.ID, dto.ReviewCommentRequest{
+		Content: "谢谢", ParentID: reply.ID,
+	})
+	if err != nil {
+		t.Fatalf("Nested reply failed: %v", err)
+	}
+	if nested.ParentID == nil || *nested.ParentID != kept.ID || nested.StartLine != 2 {
+		t.Errorf("Expected nested reply to attach to root thread, got %+v", nested)
+	}
+
+	t.Run("invalid range and outsiders are rejected", func(t *testing.T) {
+		if _, err := service.AddReviewComment(submission.ID, fixture.Author.ID, dto.ReviewCommentRequest{
+			Content: "x", StartLine: 3, EndLine: 4,
+		}); err == nil {
+			t.Errorf("Expected error for range beyond the proposed version")
+		}
+		if _, err := service.AddReviewComment(submission.ID, fixture.ModeratorUser.ID, dto.ReviewCommentRequest{
+			Content: "x", StartLine: 1, EndLine: 1,
+		}); err == nil {
+			t.Errorf("Expected permission error for non-reviewer")
+		}
+	})
+
+	// 3. 要求修改后推送新修订：第2行下移一行，第3行被改写
+	if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{
+		Action: "request_changes", Notes: "见行内评论",
+	}); err != nil {
+		t.Fatalf("request_changes failed: %v", err)
+	}
+	_, revised, err := service.ReviseSubmission(submission.ID, dto.ReviseSubmissionRequest{
+		Content: "Intro\nInitial content\nline two\nline 3 rewritten",
+	}, fixture.RegularUser.ID)
+	if err != nil {
+		t.Fatalf("ReviseSubmission failed: %v", err)
+	}
+
+	// 4. 审核详情返回讨论串，锚点随修订移动
+	detail, err := service.GetReviewDetail(submission.ID, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("GetReviewDetail failed: %v", err)
+	}
+	threads := detail["comments"].([]map[string]interface{})
+	if len(threads) != 2 {
+		t.Fatalf("Expected 2 threads, got %d", len(threads))
+	}
+
+	first := threads[0]
+	if first["id"] != kept.ID || first["version_id"] != revised.ID || first["start_line"] != 3 || first["is_outdated"] != false {
+		t.Errorf("Expected first thread re-anchored to line 3 of the new revision, got %+v", first)
+	}
+	if first["original_start_line"] != 2 || first["original_version_id"] != submission.ProposedVersionID {
+		t.Errorf("Expected original anchor to be kept, got %+v", first)
+	}
+	replies := first["replies"].([]map[string]interface{})
+	if len(replies) != 2 || replies[0]["start_line"] != 3 {
+		t.Errorf("Expected replies to follow the root anchor, got %+v", replies)
+	}
+
+	second := threads[1]
+	if second["id"] != removed.ID || second["is_outdated"] != true || second["start_line"] != 3 {
+		t.Errorf("Expected second thread to be outdated at its original lines, got %+v", second)
+	}
+}
@@ -0,0 +1,113 @@
+package article_test
+
+import (
+	"strings"
+	"testing"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestSearchArticles_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+	target := fixture.TestArticle
+
+	search := func(query string, moduleID uint, tags []string) []map[string]interface{} {
+		t.Helper()
+		result, err := service.SearchArticles(query, moduleID, tags, 1, 20)
+		if err != nil {
+			t.Fatalf("SearchArticles(%q) failed: %v", query, err)
+		}
+		return result["results"].([]map[string]interface{})
+	}
+	found := func(results []map[string]interface{}) bool {
+		for _, item := range results {
+			if item["id"] == target.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// 1. 启动时补建历史文章的索引
+	if _, err := service.ReindexStaleArticles(); err != nil {
+		t.Fatalf("ReindexStaleArticles failed: %v", err)
+	}
+	if !found(search("Initial", 0, nil)) {
+		t.Fatalf("Expected reindexed article to be searchable")
+	}
+
+	// 2. 发布新版本后增量更新索引（中文按字/二元组切分）
+	content := "<p>本文介绍分布式系统中的一致性协议，包括 Raft 与 Paxos。</p>"
+	if _, _, err := service.CreateSubmission(target.ID, dto.SubmissionRequest{
+		Content:       content,
+		CommitMessage: "rewrite",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.Author.ID, ""); err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+
+	t.Run("chinese and english terms", func(t *testing.T) {
+		results := search("分布式系统 raft", 0, nil)
+		if !found(results) {
+			t.Fatalf("Expected article to match, got %+v", results)
+		}
+		snippet := results[0]["snippet"].(string)
+		if !strings.Contains(snippet, "<mark>分布式系统</mark>") || !strings.Contains(snippet, "<mark>Raft</mark>") {
+			t.Errorf("Expected highlighted snippet, got %q", snippet)
+		}
+		if strings.Contains(snippet, "<p>") {
+			t.Errorf("Expected HTML to be stripped from snippet, got %q", snippet)
+		}
+		if found(search("Initial", 0, nil)) {
+			t.Errorf("Expected old content to be removed from the index")
+		}
+		if !found(search("协", 0, nil)) {
+			t.Errorf("Expected single character query to match")
+		}
+		if found(search("分布式 zookeeper", 0, nil)) {
+			t.Errorf("Expected all terms to be required")
+		}
+	})
+
+	t.Run("title changes are indexed with higher weight", func(t *testing.T) {
+		other := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+		otherVersion := fixture.BaseVersion
+		otherVersion.ID = 0
+		otherVersion.ArticleID = other.ID
+		otherVersion.Content = "共识算法的正文"
+		db.Create(&otherVersion)
+		other.CurrentVersionID = &otherVersion.ID
+		db.Save(other)
+		if _, err := service.ReindexStaleArticles(); err != nil {
+			t.Fatalf("ReindexStaleArticles failed: %v", err)
+		}
+
+		title := "共识算法入门"
+		if err := service.UpdateBasicInfo(target.ID, fixture.Author.ID, "", dto.UpdateArticleBasicInfoRequest{Title: &title}); err != nil {
+			t.Fatalf("UpdateBasicInfo failed: %v", err)
+		}
+		results := search("共识算法", 0, nil)
+		if len(results) < 2 || results[0]["id"] != target.ID {
+			t.Fatalf("Expected title match to rank first, got %+v", results)
+		}
+		if results[0]["title_highlight"] != "<mark>共识算法</mark>入门" {
+			t.Errorf("Unexpected title highlight %q", results[0]["title_highlight"])
+		}
+	})
+
+	t.Run("filters and validation", func(t *testing.T) {
+		otherModule := testutils.CreateTestModule(db, fixture.Author.ID)
+		if found(search("分布式", otherModule.ID, nil)) {
+			t.Errorf("Expected module filter to exclude article")
+		}
+		if found(search("分布式", 0, []string{"search-missing-tag"})) {
+			t.Errorf("Expected tag filter to exclude article")
+		}
+		if _, err := service.SearchArticles(" ，。", 0, nil, 1, 20); err == nil {
+			t.Errorf("Expected error for empty query")
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package article_test
+
+import (
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/database"
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/model/discussion"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestGetArticleStats_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+	articleID := fixture.TestArticle.ID
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	db.Create(&article.ArticleDailyStat{ArticleID: articleID, Date: yesterday, Views: 12, UniqueVisitors: 5, UpdatedAt: now})
+	db.Create(&article.ArticleDailyStat{ArticleID: articleID, Date: today, Views: 3, UniqueVisitors: 2, UpdatedAt: now})
+
+	// 今天：初始版本已发布，另有一个待审核提交和两条评论（其中一条已删除）
+	if _, _, err := service.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       "Proposed content",
+		CommitMessage: "proposal",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.RegularUser.ID, ""); err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+	thread := &discussion.Discussion{ArticleID: articleID, Title: "Discussion", CreatedBy: fixture.Author.ID}
+	db.Create(thread)
+	db.Create(&discussion.DiscussionComment{DiscussionID: thread.ID, Content: "Nice", CreatedBy: fixture.RegularUser.ID})
+	db.Create(&discussion.DiscussionComment{DiscussionID: thread.ID, Content: "Spam", CreatedBy: fixture.RegularUser.ID, IsDeleted: true})
+
+	start := yesterday.Format("2006-01-02")
+	end := today.Format("2006-01-02")
+
+	t.Run("series and totals", func(t *testing.T) {
+		result, err := service.GetArticleStats(articleID, fixture.Author.ID, "", start, end)
+		if err != nil {
+			t.Fatalf("GetArticleStats failed: %v", err)
+		}
+		series := result["series"].([]map[string]interface{})
+		if len(series) != 2 || series[0]["date"] != start || series[1]["date"] != end {
+			t.Fatalf("Expected 2 days from %s to %s, got %+v", start, end, series)
+		}
+		if series[0]["views"] != int64(12) || series[0]["edits"] != int64(0) {
+			t.Errorf("Unexpected stats for yesterday: %+v", series[0])
+		}
+		if series[1]["unique_visitors"] != int64(2) || series[1]["edits"] != int64(1) ||
+			series[1]["submissions"] != int64(1) || series[1]["comments"] != int64(1) {
+			t.Errorf("Unexpected stats for today: %+v", series[1])
+		}
+		totals := result["totals"].(map[string]interface{})
+		if totals["views"] != int64(15) || totals["visitor_days"] != int64(7) || totals["unique_visitors"] != nil {
+			t.Errorf("Unexpected totals: %+v", totals)
+		}
+		revisions := result["revisions"].([]map[string]interface{})
+		if len(revisions) != 1 || revisions[0]["version_id"] != fixture.BaseVersion.ID {
+			t.Errorf("Expected the published base version, got %+v", revisions)
+		}
+	})
+
+	t.Run("permissions and validation", func(t *testing.T) {
+		if _, err := service.GetArticleStats(articleID, fixture.RegularUser.ID, "", start, end); err == nil {
+			t.Errorf("Expected permission error for regular user")
+		}
+		if _, err := service.GetArticleStats(articleID, fixture.GlobalAdmin.ID, "admin", start, end); err != nil {
+			t.Errorf("Expected global admin to view stats: %v", err)
+		}
+		if _, err := service.GetArticleStats(articleID, fixture.Author.ID, "", end, start); err == nil {
+			t.Errorf("Expected error when start is after end")
+		}
+		if _, err := service.GetArticleStats(articleID, fixture.Author.ID, "", "2024-01-01", "2025-12-31"); err == nil {
+			t.Errorf("Expected error for a range longer than a year")
+		}
+		if result, err := service.GetArticleStats(articleID, fixture.Author.ID, "", "", ""); err != nil ||
+			len(result["series"].([]map[string]interface{})) != 30 {
+			t.Errorf("Expected default 30 day range, got %v", err)
+		}
+	})
+
+	t.Run("flush daily stats from redis", func(t *testing.T) {
+		redisClient := testutils.SetupTestRedis(t)
+		if redisClient == nil {
+			t.Skip("Redis not available, skipping daily stats flush")
+		}
+		previous := database.RedisDB
+		database.RedisDB = redisClient
+		t.Cleanup(func() { database.RedisDB = previous })
+
+		other := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+		service.RecordArticleView(other.ID, fixture.RegularUser.ID, "")
+		service.RecordArticleView(other.ID, fixture.RegularUser.ID, "")
+		service.RecordArticleView(other.ID, 0, "device-a")
+		for i := 0; i < 2; i++ {
+			if _, err := service.FlushViewCounts(); err != nil {
+				t.Fatalf("FlushViewCounts failed: %v", err)
+			}
+		}
+
+		var stat article.ArticleDailyStat
+		if err := db.Where("article_id = ? AND date = ?", other.ID, today).First(&stat).Error; err != nil {
+			t.Fatalf("Expected daily stats row: %v", err)
+		}
+		if stat.Views != 3 || stat.UniqueVisitors != 2 {
+			t.Errorf("Expected 3 views from 2 visitors, got %+v", stat)
+		}
+	})
+}
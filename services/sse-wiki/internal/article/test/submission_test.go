@@ -455,3 +455,291 @@ func TestReviewSubmission_ConflictResolution_Integration(t *testing.T) {
 		})
 	}
 }
+
+func TestWithdrawSubmission_Integration(t *testing.T) {
+	createPending := func(t *testing.T, fixture *ArticleTestFixture) *article.ReviewSubmission {
+		submission, _, err := fixture.Service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+			Content:       "Initial content\nstale proposal",
+			CommitMessage: "stale proposal",
+			BaseVersionID: fixture.BaseVersion.ID,
+		}, fixture.RegularUser.ID, "")
+		if err != nil || submission == nil {
+			t.Fatalf("Failed to create pending submission: %v", err)
+		}
+		return submission
+	}
+
+	t.Run("submitter withdraws own submission", func(t *testing.T) {
+		fixture := createArticleFixture(t)
+		submission := createPending(t, fixture)
+
+		withdrawn, err := fixture.Service.WithdrawSubmission(submission.ID, fixture.RegularUser.ID)
+		if err != nil {
+			t.Fatalf("WithdrawSubmission failed: %v", err)
+		}
+		if withdrawn.Status != "withdrawn" || withdrawn.ReviewedBy != nil {
+			t.Errorf("Expected withdrawn submission without reviewer, got %+v", withdrawn)
+		}
+		if withdrawn.WithdrawnBy == nil || *withdrawn.WithdrawnBy != fixture.RegularUser.ID || withdrawn.WithdrawnAt == nil {
+			t.Errorf("Expected withdrawn_by/withdrawn_at to be recorded, got %+v", withdrawn)
+		}
+
+		var version article.ArticleVersion
+		fixture.DB.First(&version, submission.ProposedVersionID)
+		if version.Status != "withdrawn" {
+			t.Errorf("Expected proposed version status 'withdrawn', got %q", version.Status)
+		}
+
+		// 已撤回的提交不能再被审核或重复撤回
+		if _, err := fixture.Service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "approve"}); err == nil {
+			t.Errorf("Expected error when reviewing a withdrawn submission")
+		}
+		if _, err := fixture.Service.WithdrawSubmission(submission.ID, fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected error when withdrawing twice")
+		}
+	})
+
+	t.Run("article admin can withdraw, others cannot", func(t *testing.T) {
+		fixture := createArticleFixture(t)
+		submission := createPending(t, fixture)
+
+		if _, err := fixture.Service.WithdrawSubmission(submission.ID, fixture.ModeratorUser.ID); err == nil {
+			t.Errorf("Expected permission error for unrelated user")
+		}
+		if _, err := fixture.Service.WithdrawSubmission(submission.ID, fixture.GlobalAdmin.ID); err == nil {
+			t.Errorf("Expected permission error for Global_Admin")
+		}
+
+		withdrawn, err := fixture.Service.WithdrawSubmission(submission.ID, fixture.Author.ID)
+		if err != nil {
+			t.Fatalf("Expected article admin to withdraw: %v", err)
+		}
+		if *withdrawn.WithdrawnBy != fixture.Author.ID {
+			t.Errorf("Expected withdrawn_by to be the article admin")
+		}
+	})
+
+	t.Run("withdrawn submission shown distinctly in history", func(t *testing.T) {
+		fixture := createArticleFixture(t)
+		submission := createPending(t, fixture)
+
+		if _, err := fixture.Service.WithdrawSubmission(submission.ID, fixture.RegularUser.ID); err != nil {
+			t.Fatalf("WithdrawSubmission failed: %v", err)
+		}
+
+		result, err := fixture.Service.GetArticle(fixture.TestArticle.ID, fixture.Author.ID, "")
+		if err != nil {
+			t.Fatalf("GetArticle failed: %v", err)
+		}
+		history := result["history"].([]map[string]interface{})
+
+		found := 0
+		for _, entry := range history {
+			if entry["version_id"] != submission.ProposedVersionID {
+				continue
+			}
+			found++
+			if entry["entry_type"] != "submission" || entry["submission_status"] != "withdrawn" {
+				t.Errorf("Expected withdrawn submission entry, got %+v", entry)
+			}
+			if entry["reviewed_by"] != (*uint)(nil) {
+				t.Errorf("Expected no reviewer on withdrawn entry, got %v", entry["reviewed_by"])
+			}
+		}
+		if found != 1 {
+			t.Errorf("Expected exactly one history entry for the withdrawn submission, got %d", found)
+		}
+	})
+}
+
+func TestRequestChanges_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+
+	submission, _, err := fixture.Service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       "Initial content\nfirst attempt",
+		CommitMessage: "first attempt",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.RegularUser.ID, "")
+	if err != nil || submission == nil {
+		t.Fatalf("Failed to create pending submission: %v", err)
+	}
+	firstVersionID := submission.ProposedVersionID
+
+	t.Run("notes are required", func(t *testing.T) {
+		if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "request_changes"}); err == nil {
+			t.Errorf("Expected error when requesting changes without notes")
+		}
+	})
+
+	// 1. 审核人要求修改
+	if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{
+		Action: "request_changes",
+		Notes:  "请补充示例",
+	}); err != nil {
+		t.Fatalf("request_changes failed: %v", err)
+	}
+	var reviewed article.ReviewSubmission
+	fixture.DB.First(&reviewed, submission.ID)
+	if reviewed.Status != "changes_requested" || reviewed.ReviewNotes != "请补充示例" {
+		t.Fatalf("Expected changes_requested with notes, got %+v", reviewed)
+	}
+
+	t.Run("only the submitter can revise", func(t *testing.T) {
+		if _, _, err := service.ReviseSubmission(submission.ID, dto.ReviseSubmissionRequest{Content: "x"}, fixture.Author.ID); err == nil {
+			t.Errorf("Expected permission error for non-submitter")
+		}
+	})
+
+	// 2. 提交者推送新修订
+	updated, revised, err := service.ReviseSubmission(submission.ID, dto.ReviseSubmissionRequest{
+		Content: "Initial content\nsecond attempt with example",
+	}, fixture.RegularUser.ID)
+	if err != nil {
+		t.Fatalf("ReviseSubmission failed: %v", err)
+	}
+	if updated.ID != submission.ID || updated.Status != "pending" || updated.ProposedVersionID != revised.ID {
+		t.Errorf("Expected same submission back in queue with new revision, got %+v", updated)
+	}
+	if revised.CommitMessage != "first attempt" || revised.Status != "pending" {
+		t.Errorf("Expected revised pending version to inherit commit message, got %+v", revised)
+	}
+
+	var previous article.ArticleVersion
+	fixture.DB.First(&previous, firstVersionID)
+	if previous.Status != "superseded" {
+		t.Errorf("Expected earlier revision to be superseded, got %q", previous.Status)
+	}
+
+	// 3. 审核详情中保留历史修订和审核意见
+	detail, err := service.GetReviewDetail(submission.ID, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("GetReviewDetail failed: %v", err)
+	}
+	if detail["revision_number"] != 2 {
+		t.Errorf("Expected current revision 2, got %v", detail["revision_number"])
+	}
+	revisions := detail["revisions"].([]map[string]interface{})
+	if len(revisions) != 1 || revisions[0]["version_id"] != firstVersionID || revisions[0]["review_notes"] != "请补充示例" {
+		t.Errorf("Expected earlier revision with review notes, got %+v", revisions)
+	}
+
+	t.Run("revising is only allowed after changes are requested", func(t *testing.T) {
+		if _, _, err := service.ReviseSubmission(submission.ID, dto.ReviseSubmissionRequest{Content: "x"}, fixture.RegularUser.ID); err == nil {
+			t.Errorf("Expected error when revising a pending submission")
+		}
+	})
+
+	// 4. 新修订审核通过后发布
+	if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "approve"}); err != nil {
+		t.Fatalf("Approve revised submission failed: %v", err)
+	}
+	var art article.Article
+	fixture.DB.First(&art, fixture.TestArticle.ID)
+	if art.CurrentVersionID == nil || *art.CurrentVersionID != revised.ID {
+		t.Errorf("Expected revised version to be published")
+	}
+}
+
+func TestReviewQuorum_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+
+	// 模块要求两人审核通过，文章未单独设置时继承
+	db.Model(fixture.TestModule).Update("required_approvals", 2)
+
+	extraModerator := testutils.CreateTestUser(db)
+	for _, reviewer := range []uint{fixture.AdminUser.ID, fixture.ModeratorUser.ID, extraModerator.ID} {
+		db.Create(&article.ArticleCollaborator{
+			ArticleID: fixture.TestArticle.ID,
+			UserID:    reviewer,
+			Role:      "moderator",
+			CreatedAt: time.Now(),
+		})
+	}
+
+	submission, _, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       "Initial content\nexam guide update",
+		CommitMessage: "update exam guide",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.RegularUser.ID, "")
+	if err != nil || submission == nil {
+		t.Fatalf("Failed to create pending submission: %v", err)
+	}
+
+	vote := func(reviewerID uint, action string) map[string]interface{} {
+		t.Helper()
+		result, err := service.ReviewSubmission(submission.ID, reviewerID, "", dto.ReviewActionRequest{Action: action, Notes: action})
+		if err != nil {
+			t.Fatalf("%s by %d failed: %v", action, reviewerID, err)
+		}
+		return result.(map[string]interface{})
+	}
+	status := func() string {
+		var s article.ReviewSubmission
+		db.First(&s, submission.ID)
+		return s.Status
+	}
+
+	// 1. 第一票通过不足以合并
+	result := vote(fixture.Author.ID, "approve")
+	if result["approvals"] != 1 || result["required_approvals"] != 2 || status() != "pending" {
+		t.Fatalf("Expected approval to be recorded without merging, got %+v (status %s)", result, status())
+	}
+
+	// 2. 驳回票阻止合并，但未达到人数时不驳回提交
+	vote(fixture.ModeratorUser.ID, "reject")
+	if status() != "pending" {
+		t.Fatalf("Expected single rejection to block but not close the submission, got %s", status())
+	}
+
+	// 3. 通过票需要抵消驳回票
+	result = vote(fixture.AdminUser.ID, "approve")
+	if result["published_version"] != nil || status() != "pending" {
+		t.Fatalf("Expected rejection to keep blocking the merge, got %+v", result)
+	}
+
+	// 4. 审核详情中可见每一票
+	detail, err := service.GetReviewDetail(submission.ID, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("GetReviewDetail failed: %v", err)
+	}
+	votes := detail["votes"].([]map[string]interface{})
+	if len(votes) != 3 || detail["approvals"] != 2 || detail["rejections"] != 1 {
+		t.Errorf("Expected 3 votes (2 approve, 1 reject), got %+v", detail)
+	}
+
+	// 5. 足够多的通过票推翻驳回后合并
+	result = vote(extraModerator.ID, "approve")
+	if result["published_version"] == nil || status() != "merged" {
+		t.Fatalf("Expected quorum to override rejection and merge, got %+v (status %s)", result, status())
+	}
+
+	t.Run("article setting overrides module", func(t *testing.T) {
+		required := 1
+		if err := service.UpdateBasicInfo(fixture.TestArticle.ID, fixture.Author.ID, "", dto.UpdateArticleBasicInfoRequest{
+			RequiredApprovals: &required,
+		}); err != nil {
+			t.Fatalf("UpdateBasicInfo failed: %v", err)
+		}
+
+		var art article.Article
+		db.First(&art, fixture.TestArticle.ID)
+		next, _, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+			Content:       "Initial content\nexam guide update\nmore",
+			CommitMessage: "another update",
+			BaseVersionID: *art.CurrentVersionID,
+		}, fixture.RegularUser.ID, "")
+		if err != nil || next == nil {
+			t.Fatalf("Failed to create submission: %v", err)
+		}
+		result, err := service.ReviewSubmission(next.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "approve"})
+		if err != nil {
+			t.Fatalf("Approve failed: %v", err)
+		}
+		if result.(map[string]interface{})["published_version"] == nil {
+			t.Errorf("Expected single approval to merge when article requires one reviewer")
+		}
+	})
+}
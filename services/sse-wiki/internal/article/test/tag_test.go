@@ -0,0 +1,231 @@
+package article_test
+
+import (
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestTagManagement_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+
+	createTag := func(name string, articleIDs ...uint) *article.Tag {
+		tag := &article.Tag{Name: name, Color: "#3b82f6", CreatedAt: time.Now()}
+		if err := db.Create(tag).Error; err != nil {
+			t.Fatalf("Failed to create tag: %v", err)
+		}
+		for _, id := range articleIDs {
+			db.Create(&article.ArticleTag{ArticleID: id, TagID: tag.ID, CreatedAt: time.Now()})
+		}
+		return tag
+	}
+
+	first := fixture.TestArticle
+	second := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+	third := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+
+	goTag := createTag("tagtest-go", first.ID, second.ID)
+	golangTag := createTag("tagtest-golang", first.ID, third.ID)
+	unusedTag := createTag("tagtest-unused")
+
+	t.Run("list with usage counts", func(t *testing.T) {
+		result, err := service.ListTags("tagtest-", "", 1, 20)
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		tags := result["tags"].([]map[string]interface{})
+		if result["total"] != int64(3) || len(tags) != 3 {
+			t.Fatalf("Expected 3 tags, got %+v", result)
+		}
+		if tags[2]["id"] != unusedTag.ID || tags[2]["usage_count"] != int64(0) {
+			t.Errorf("Expected unused tag last, got %+v", tags[2])
+		}
+	})
+
+	t.Run("autocomplete by prefix", func(t *testing.T) {
+		tags, err := service.AutocompleteTags("tagtest-go", 10)
+		if err != nil {
+			t.Fatalf("AutocompleteTags failed: %v", err)
+		}
+		if len(tags) != 2 {
+			t.Errorf("Expected 2 suggestions, got %+v", tags)
+		}
+	})
+
+	t.Run("management requires global admin", func(t *testing.T) {
+		if _, err := service.RenameTag(unusedTag.ID, "tagtest-other", "user"); err == nil {
+			t.Errorf("Expected permission error for rename")
+		}
+		if _, err := service.MergeTags([]uint{golangTag.ID}, goTag.ID, ""); err == nil {
+			t.Errorf("Expected permission error for merge")
+		}
+		if err := service.DeleteTag(unusedTag.ID, "user"); err == nil {
+			t.Errorf("Expected permission error for delete")
+		}
+	})
+
+	t.Run("rename and color", func(t *testing.T) {
+		if _, err := service.RenameTag(golangTag.ID, goTag.Name, "admin"); err == nil {
+			t.Errorf("Expected error when renaming to an existing tag name")
+		}
+		tag, err := service.RenameTag(unusedTag.ID, "tagtest-spare", "admin")
+		if err != nil || tag["name"] != "tagtest-spare" {
+			t.Errorf("RenameTag failed: %v, %+v", err, tag)
+		}
+
+		if _, err := service.UpdateTagColor(goTag.ID, "blue", "admin"); err == nil {
+			t.Errorf("Expected error for invalid color")
+		}
+		tag, err = service.UpdateTagColor(goTag.ID, "#ff0000", "admin")
+		if err != nil || tag["color"] != "#ff0000" {
+			t.Errorf("UpdateTagColor failed: %v, %+v", err, tag)
+		}
+	})
+
+	t.Run("merge duplicate tags", func(t *testing.T) {
+		if _, err := service.MergeTags([]uint{goTag.ID}, goTag.ID, "admin"); err == nil {
+			t.Errorf("Expected error when merging a tag into itself")
+		}
+		tag, err := service.MergeTags([]uint{golangTag.ID}, goTag.ID, "admin")
+		if err != nil {
+			t.Fatalf("MergeTags failed: %v", err)
+		}
+		if tag["usage_count"] != int64(3) {
+			t.Errorf("Expected merged tag to be used by 3 articles, got %v", tag["usage_count"])
+		}
+		if err := db.First(&article.Tag{}, golangTag.ID).Error; err == nil {
+			t.Errorf("Expected source tag to be deleted")
+		}
+		var remaining int64
+		db.Model(&article.ArticleTag{}).Where("tag_id = ?", golangTag.ID).Count(&remaining)
+		if remaining != 0 {
+			t.Errorf("Expected source tag links to be re-pointed, %d left", remaining)
+		}
+	})
+
+	t.Run("delete only unused tags", func(t *testing.T) {
+		if err := service.DeleteTag(goTag.ID, "admin"); err == nil {
+			t.Errorf("Expected error when deleting a tag in use")
+		}
+		if err := service.DeleteTag(unusedTag.ID, "admin"); err != nil {
+			t.Errorf("DeleteTag failed: %v", err)
+		}
+	})
+
+	t.Run("wildcards in keyword match literally", func(t *testing.T) {
+		literal := createTag("tagtest_50%")
+
+		// 未转义时 "_" 会匹配 "tagtest-go" 中的 "-"，"%" 会匹配所有标签
+		result, err := service.ListTags("tagtest_", "", 1, 20)
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		tags := result["tags"].([]map[string]interface{})
+		if len(tags) != 1 || tags[0]["id"] != literal.ID {
+			t.Errorf("Expected only the literal match, got %+v", tags)
+		}
+
+		result, err = service.ListTags("%", "", 1, 20)
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		tags = result["tags"].([]map[string]interface{})
+		if len(tags) != 1 || tags[0]["id"] != literal.ID {
+			t.Errorf("Expected %% to match only tags containing it, got %+v", tags)
+		}
+
+		suggestions, err := service.AutocompleteTags("tagtest_", 10)
+		if err != nil {
+			t.Fatalf("AutocompleteTags failed: %v", err)
+		}
+		if len(suggestions) != 1 {
+			t.Errorf("Expected only the literal prefix match, got %+v", suggestions)
+		}
+	})
+}
+
+func TestGetArticlesByTags_Integration(t *testing.T) {
+	service, db := setupArticleService(t)
+	author := testutils.CreateTestUser(db)
+	parent := testutils.CreateTestModule(db, author.ID)
+	child := testutils.CreateTestModule(db, author.ID, testutils.WithParentID(parent.ID))
+	other := testutils.CreateTestModule(db, author.ID)
+
+	both := testutils.CreateTestArticle(db, parent.ID, author.ID)
+	onlyX := testutils.CreateTestArticle(db, child.ID, author.ID)
+	onlyY := testutils.CreateTestArticle(db, other.ID, author.ID)
+	db.Model(&article.Article{}).Where("id = ?", onlyX.ID).UpdateColumn("view_count", 100)
+
+	tagX := &article.Tag{Name: "bytag-x", CreatedAt: time.Now()}
+	tagY := &article.Tag{Name: "bytag-y", CreatedAt: time.Now()}
+	db.Create(tagX)
+	db.Create(tagY)
+	for _, link := range []article.ArticleTag{
+		{ArticleID: both.ID, TagID: tagX.ID},
+		{ArticleID: both.ID, TagID: tagY.ID},
+		{ArticleID: onlyX.ID, TagID: tagX.ID},
+		{ArticleID: onlyY.ID, TagID: tagY.ID},
+	} {
+		db.Create(&link)
+	}
+
+	ids := func(result map[string]interface{}) []uint {
+		items := result["articles"].([]map[string]interface{})
+		out := make([]uint, len(items))
+		for i, item := range items {
+			out[i] = item["id"].(uint)
+		}
+		return out
+	}
+
+	t.Run("OR matches any tag", func(t *testing.T) {
+		result, err := service.GetArticlesByTags([]string{"bytag-x", "bytag-y"}, false, 0, "", 1, 20)
+		if err != nil {
+			t.Fatalf("GetArticlesByTags failed: %v", err)
+		}
+		if result["total"] != int64(3) {
+			t.Errorf("Expected 3 articles, got %v", result["total"])
+		}
+	})
+
+	t.Run("AND matches all tags", func(t *testing.T) {
+		result, err := service.GetArticlesByTags([]string{"bytag-x", "bytag-y", "bytag-x"}, true, 0, "", 1, 20)
+		if err != nil {
+			t.Fatalf("GetArticlesByTags failed: %v", err)
+		}
+		if got := ids(result); len(got) != 1 || got[0] != both.ID {
+			t.Errorf("Expected only article %d, got %v", both.ID, got)
+		}
+	})
+
+	t.Run("module subtree and sorting", func(t *testing.T) {
+		result, err := service.GetArticlesByTags([]string{"bytag-x", "bytag-y"}, false, parent.ID, "view_count", 1, 20)
+		if err != nil {
+			t.Fatalf("GetArticlesByTags failed: %v", err)
+		}
+		if got := ids(result); len(got) != 2 || got[0] != onlyX.ID || got[1] != both.ID {
+			t.Errorf("Expected [%d %d] sorted by view count, got %v", onlyX.ID, both.ID, got)
+		}
+		tags := result["articles"].([]map[string]interface{})[1]["tags"].([]string)
+		if len(tags) != 2 {
+			t.Errorf("Expected list items to include tags, got %v", tags)
+		}
+	})
+
+	t.Run("pagination and validation", func(t *testing.T) {
+		result, err := service.GetArticlesByTags([]string{"bytag-x", "bytag-y"}, false, 0, "", 2, 2)
+		if err != nil {
+			t.Fatalf("GetArticlesByTags failed: %v", err)
+		}
+		if result["total"] != int64(3) || len(ids(result)) != 1 {
+			t.Errorf("Expected 1 article on page 2, got %v", ids(result))
+		}
+		if _, err := service.GetArticlesByTags([]string{" "}, false, 0, "", 1, 20); err == nil {
+			t.Errorf("Expected error without tags")
+		}
+	})
+}
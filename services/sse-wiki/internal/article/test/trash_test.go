@@ -0,0 +1,150 @@
+package article_test
+
+import (
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestTrash_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+	articleID := fixture.TestArticle.ID
+
+	if _, _, err := service.CreateSubmission(articleID, dto.SubmissionRequest{
+		Content:       "Initial content\npending change",
+		CommitMessage: "pending change",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.RegularUser.ID, ""); err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	if _, err := service.UpdateUserFavouriteArticle(uint32(fixture.RegularUser.ID), uint32(articleID), true); err != nil {
+		t.Fatalf("Failed to add favorite: %v", err)
+	}
+
+	countRelated := func() (versions, submissions, collaborators int64) {
+		db.Model(&article.ArticleVersion{}).Where("article_id = ?", articleID).Count(&versions)
+		db.Model(&article.ReviewSubmission{}).Where("article_id = ?", articleID).Count(&submissions)
+		db.Model(&article.ArticleCollaborator{}).Where("article_id = ?", articleID).Count(&collaborators)
+		return
+	}
+	versions, submissions, collaborators := countRelated()
+
+	// 1. 删除后文章进入回收站，关联数据保留
+	if err := service.DeleteArticle(articleID, fixture.Author.ID, "user"); err != nil {
+		t.Fatalf("DeleteArticle failed: %v", err)
+	}
+	var trashed article.Article
+	if err := db.Unscoped().First(&trashed, articleID).Error; err != nil || !trashed.DeletedAt.Valid {
+		t.Fatalf("Expected article to be soft deleted, err=%v", err)
+	}
+	if trashed.DeletedBy == nil || *trashed.DeletedBy != fixture.Author.ID {
+		t.Errorf("Expected deleted_by to be recorded")
+	}
+	if v, s, c := countRelated(); v != versions || s != submissions || c != collaborators {
+		t.Errorf("Expected related data to be kept, got versions=%d submissions=%d collaborators=%d", v, s, c)
+	}
+
+	// 2. 模块管理员可以查看回收站
+	t.Run("list trash requires module admin", func(t *testing.T) {
+		if _, err := service.ListTrash(fixture.TestModule.ID, fixture.RegularUser.ID, "user", 1, 20); err == nil {
+			t.Errorf("Expected permission error for regular user")
+		}
+
+		result, err := service.ListTrash(fixture.TestModule.ID, fixture.Author.ID, "user", 1, 20)
+		if err != nil {
+			t.Fatalf("ListTrash failed: %v", err)
+		}
+		items := result["articles"].([]map[string]interface{})
+		if result["total"] != int64(1) || len(items) != 1 || items[0]["id"] != articleID {
+			t.Errorf("Expected trashed article in list, got %+v", result)
+		}
+	})
+
+	t.Run("trashed article is hidden from reviews and favorites", func(t *testing.T) {
+		reviews, err := service.GetReviews("pending", nil)
+		if err != nil {
+			t.Fatalf("GetReviews failed: %v", err)
+		}
+		for _, review := range reviews {
+			if review.ArticleID == articleID {
+				t.Errorf("Expected pending submission of trashed article to be hidden")
+			}
+		}
+
+		favorites, err := service.GetUserFavouriteArticle(fixture.RegularUser.ID)
+		if err != nil {
+			t.Fatalf("GetUserFavouriteArticle failed: %v", err)
+		}
+		for _, id := range favorites {
+			if uint(id) == articleID {
+				t.Errorf("Expected trashed article to be hidden from favorites")
+			}
+		}
+	})
+
+	// 3. 恢复后文章和历史完整可见
+	if err := service.RestoreArticle(articleID, fixture.RegularUser.ID, "user"); err == nil {
+		t.Errorf("Expected permission error when regular user restores")
+	}
+	if err := service.RestoreArticle(articleID, fixture.Author.ID, "user"); err != nil {
+		t.Fatalf("RestoreArticle failed: %v", err)
+	}
+	restored, err := service.GetVersions(articleID)
+	if err != nil || int64(len(restored)) != versions {
+		t.Errorf("Expected %d versions after restore, got %d (err=%v)", versions, len(restored), err)
+	}
+	if err := service.PurgeArticle(articleID, fixture.Author.ID, "user"); err == nil {
+		t.Errorf("Expected error when purging an article that is not in the trash")
+	}
+
+	// 4. 永久删除清除所有关联数据
+	if err := service.DeleteArticle(articleID, fixture.Author.ID, "user"); err != nil {
+		t.Fatalf("DeleteArticle failed: %v", err)
+	}
+	if err := service.PurgeArticle(articleID, fixture.Author.ID, "user"); err != nil {
+		t.Fatalf("PurgeArticle failed: %v", err)
+	}
+	if err := db.Unscoped().First(&article.Article{}, articleID).Error; err == nil {
+		t.Errorf("Expected article row to be removed")
+	}
+	if v, s, c := countRelated(); v != 0 || s != 0 || c != 0 {
+		t.Errorf("Expected related data to be purged, got versions=%d submissions=%d collaborators=%d", v, s, c)
+	}
+}
+
+func TestPurgeExpiredTrash_Integration(t *testing.T) {
+	service, db := setupArticleService(t)
+	author := testutils.CreateTestUser(db)
+	testModule := testutils.CreateTestModule(db, author.ID)
+	expired := testutils.CreateTestArticle(db, testModule.ID, author.ID)
+	recent := testutils.CreateTestArticle(db, testModule.ID, author.ID)
+
+	for _, id := range []uint{expired.ID, recent.ID} {
+		if err := service.DeleteArticle(id, author.ID, "user"); err != nil {
+			t.Fatalf("DeleteArticle failed: %v", err)
+		}
+	}
+	// 模拟超过保留期限
+	db.Unscoped().Model(&article.Article{}).Where("id = ?", expired.ID).
+		UpdateColumn("deleted_at", time.Now().Add(-31*24*time.Hour))
+
+	purged, err := service.PurgeExpiredTrash()
+	if err != nil {
+		t.Fatalf("PurgeExpiredTrash failed: %v", err)
+	}
+	if purged < 1 {
+		t.Errorf("Expected expired article to be purged, purged=%d", purged)
+	}
+	if err := db.Unscoped().First(&article.Article{}, expired.ID).Error; err == nil {
+		t.Errorf("Expected expired article to be removed")
+	}
+	if err := db.Unscoped().First(&article.Article{}, recent.ID).Error; err != nil {
+		t.Errorf("Expected recently deleted article to stay in the trash: %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package article_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/database"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestGetTrendingArticles_Integration(t *testing.T) {
+	redisClient := testutils.SetupTestRedis(t)
+	if redisClient == nil {
+		t.Skip("Redis not available, skipping trending tests")
+	}
+	previous := database.RedisDB
+	database.RedisDB = redisClient
+	t.Cleanup(func() { database.RedisDB = previous })
+
+	service, db := setupArticleService(t)
+	author := testutils.CreateTestUser(db)
+	parent := testutils.CreateTestModule(db, author.ID)
+	child := testutils.CreateTestModule(db, author.ID, testutils.WithParentID(parent.ID))
+	other := testutils.CreateTestModule(db, author.ID)
+
+	hot := testutils.CreateTestArticle(db, parent.ID, author.ID)
+	warm := testutils.CreateTestArticle(db, child.ID, author.ID)
+	elsewhere := testutils.CreateTestArticle(db, other.ID, author.ID)
+
+	view := func(articleID uint, readers int) {
+		for i := 0; i < readers; i++ {
+			reader := testutils.CreateTestUser(db)
+			service.RecordArticleView(articleID, reader.ID, "")
+			// 同一用户一小时内重复阅读只计一次
+			service.RecordArticleView(articleID, reader.ID, "")
+		}
+	}
+	view(hot.ID, 3)
+	view(elsewhere.ID, 2)
+	view(warm.ID, 1)
+
+	// warm 在两天前有 4 次阅读：week 窗口内衰减为 4 * 2^-2
+	oldBucket := "article:trending:hour:" + time.Now().Add(-48*time.Hour).UTC().Format("2006010215")
+	redisClient.ZIncrBy(context.Background(), oldBucket, 4, fmt.Sprint(warm.ID))
+
+	ids := func(result map[string]interface{}) []uint {
+		items := result["articles"].([]map[string]interface{})
+		out := make([]uint, len(items))
+		for i, item := range items {
+			out[i] = item["id"].(uint)
+		}
+		return out
+	}
+
+	t.Run("day window ranks recent views", func(t *testing.T) {
+		result, err := service.GetTrendingArticles("day", 0, 10)
+		if err != nil {
+			t.Fatalf("GetTrendingArticles failed: %v", err)
+		}
+		got := ids(result)
+		if len(got) != 3 || got[0] != hot.ID || got[1] != elsewhere.ID || got[2] != warm.ID {
+			t.Errorf("Expected [%d %d %d], got %v", hot.ID, elsewhere.ID, warm.ID, got)
+		}
+		if views := result["articles"].([]map[string]interface{})[0]["window_views"]; views != int64(3) {
+			t.Errorf("Expected 3 window views, got %v", views)
+		}
+	})
+
+	t.Run("week window decays older views", func(t *testing.T) {
+		result, err := service.GetTrendingArticles("", parent.ID, 10)
+		if err != nil {
+			t.Fatalf("GetTrendingArticles failed: %v", err)
+		}
+		got := ids(result)
+		if result["window"] != "week" || len(got) != 2 || got[0] != hot.ID || got[1] != warm.ID {
+			t.Errorf("Expected [%d %d] in week window, got %v", hot.ID, warm.ID, got)
+		}
+		item := result["articles"].([]map[string]interface{})[1]
+		if item["window_views"] != int64(5) || item["trending_score"].(float64) >= 3 {
+			t.Errorf("Expected old views to be decayed, got %+v", item)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		if _, err := service.GetTrendingArticles("year", 0, 10); err == nil {
+			t.Errorf("Expected error for invalid window")
+		}
+	})
+}
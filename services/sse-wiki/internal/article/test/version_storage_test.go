@@ -0,0 +1,222 @@
+package article_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	articlePkg "terminal-terrace/sse-wiki/internal/article"
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+// TestVersionStorage_Integration 验证快照+增量存储的写入与透明还原
+func TestVersionStorage_Integration(t *testing.T) {
+	_, db := setupArticleService(t)
+	versionRepo := articlePkg.NewVersionRepository(db)
+
+	author := testutils.CreateTestUser(db)
+	testModule := testutils.CreateTestModule(db, author.ID)
+	testArticle := testutils.CreateTestArticle(db, testModule.ID, author.ID)
+
+	// 每个版本在上一版本基础上追加一段，内容足够长使增量明显小于快照
+	var contents []string
+	var lines []string
+	for i := 0; i < 50; i++ {
+		lines = append(lines, fmt.Sprintf("<p>第 %d 段：SSE Wiki 是一个面向软件工程学院的协作知识库。</p>", i))
+	}
+
+	var versions []*article.ArticleVersion
+	var prevID *uint
+	for i := 0; i < 15; i++ {
+		lines[i] = fmt.Sprintf("<p>第 %d 段已在 v%d 修改</p>", i, i+1)
+		content := strings.Join(lines, "\n") + "\n"
+		contents = append(contents, content)
+
+		version := &article.ArticleVersion{
+			ArticleID:     testArticle.ID,
+			VersionNumber: i + 1,
+			Content:       content,
+			CommitMessage: "commit",
+			AuthorID:      author.ID,
+			Status:        "published",
+			BaseVersionID: prevID,
+			CreatedAt:     time.Now(),
+		}
+		if err := versionRepo.Create(version); err != nil {
+			t.Fatalf("Failed to create version %d: %v", i+1, err)
+		}
+		if version.Content != content {
+			t.Fatalf("Create should keep in-memory content for version %d", i+1)
+		}
+		versions = append(versions, version)
+		prevID = &version.ID
+	}
+
+	t.Run("storage uses snapshots and bounded delta chains", func(t *testing.T) {
+		var stored []article.ArticleVersion
+		db.Where("article_id = ?", testArticle.ID).Order("version_number").Find(&stored)
+
+		if stored[0].StorageType != articlePkg.StorageSnapshot {
+			t.Errorf("Expected v1 to be a snapshot, got %s", stored[0].StorageType)
+		}
+		deltas := 0
+		for _, v := range stored {
+			if v.Content != "" {
+				t.Errorf("Expected raw content column to be empty for v%d", v.VersionNumber)
+			}
+			if v.StorageType == articlePkg.StorageDelta {
+				deltas++
+			}
+			if v.DeltaDepth > 10 {
+				t.Errorf("Delta chain too long for v%d: %d", v.VersionNumber, v.DeltaDepth)
+			}
+		}
+		if deltas == 0 {
+			t.Errorf("Expected some versions stored as deltas")
+		}
+	})
+
+	t.Run("reads reconstruct content", func(t *testing.T) {
+		for i, v := range versions {
+			content, err := versionRepo.GetContent(v.ID)
+			if err != nil {
+				t.Fatalf("GetContent v%d failed: %v", i+1, err)
+			}
+			if content != contents[i] {
+				t.Errorf("GetContent v%d mismatch", i+1)
+			}
+		}
+
+		byNumber, err := versionRepo.GetByVersionNumber(testArticle.ID, 12)
+		if err != nil || byNumber.Content != contents[11] {
+			t.Errorf("GetByVersionNumber v12 mismatch: %v", err)
+		}
+
+		all, err := versionRepo.GetVersions(testArticle.ID)
+		if err != nil {
+			t.Fatalf("GetVersions failed: %v", err)
+		}
+		for _, v := range all {
+			if v.Content != contents[v.VersionNumber-1] {
+				t.Errorf("GetVersions v%d mismatch", v.VersionNumber)
+			}
+		}
+	})
+
+	t.Run("update re-encodes content", func(t *testing.T) {
+		last := versions[len(versions)-1]
+		last.Content = contents[len(contents)-1] + "<p>审核时合并的内容</p>\n"
+		if err := versionRepo.Update(last); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		content, err := versionRepo.GetContent(last.ID)
+		if err != nil || content != last.Content {
+			t.Errorf("Expected updated content after re-encoding, err=%v", err)
+		}
+	})
+
+	t.Run("rewriting a pending base keeps dependent versions intact", func(t *testing.T) {
+		published := versions[len(versions)-2]
+		pending := &article.ArticleVersion{
+			ArticleID:     testArticle.ID,
+			VersionNumber: 100,
+			Content:       contents[len(contents)-2] + "<p>待审核的修改</p>\n",
+			CommitMessage: "pending",
+			AuthorID:      author.ID,
+			Status:        "pending",
+			BaseVersionID: &published.ID,
+			CreatedAt:     time.Now(),
+		}
+		if err := versionRepo.Create(pending); err != nil {
+			t.Fatalf("Failed to create pending version: %v", err)
+		}
+
+		// 以待审核版本为基础的新版本不能写成增量
+		dependentContent := pending.Content + "<p>基于待审核版本的修改</p>\n"
+		dependent := &article.ArticleVersion{
+			ArticleID:     testArticle.ID,
+			VersionNumber: 101,
+			Content:       dependentContent,
+			CommitMessage: "dependent",
+			AuthorID:      author.ID,
+			Status:        "pending",
+			BaseVersionID: &pending.ID,
+			CreatedAt:     time.Now(),
+		}
+		if err := versionRepo.Create(dependent); err != nil {
+			t.Fatalf("Failed to create dependent version: %v", err)
+		}
+		if dependent.StorageType != articlePkg.StorageSnapshot {
+			t.Errorf("Expected version based on a pending version to be a snapshot, got %s", dependent.StorageType)
+		}
+
+		// 原地改写待审核版本后，依赖它的版本仍能正确还原
+		pending.Content = "<p>完全重写的待审核内容</p>\n"
+		if err := versionRepo.Update(pending); err != nil {
+			t.Fatalf("Failed to rewrite pending version: %v", err)
+		}
+		content, err := versionRepo.GetContent(dependent.ID)
+		if err != nil {
+			t.Fatalf("GetContent dependent failed: %v", err)
+		}
+		if content != dependentContent {
+			t.Errorf("Dependent version content changed after rewriting its base")
+		}
+	})
+
+	t.Run("migration converts legacy full rows", func(t *testing.T) {
+		legacyArticle := testutils.CreateTestArticle(db, testModule.ID, author.ID)
+		legacyContents := []string{"line 1\nline 2\n", "line 1\nline 2 edited\n", "line 1\nline 2 edited\nline 3"}
+
+		var legacyIDs []uint
+		var prevID *uint
+		for i, content := range legacyContents {
+			// 直接写库模拟迁移前的全量存储数据
+			version := &article.ArticleVersion{
+				ArticleID:     legacyArticle.ID,
+				VersionNumber: i + 1,
+				Content:       content,
+				AuthorID:      author.ID,
+				Status:        "published",
+				BaseVersionID: prevID,
+				CreatedAt:     time.Now(),
+			}
+			if err := db.Create(version).Error; err != nil {
+				t.Fatalf("Failed to create legacy version: %v", err)
+			}
+			legacyIDs = append(legacyIDs, version.ID)
+			prevID = &version.ID
+		}
+
+		// 迁移前可正常读取
+		if content, err := versionRepo.GetContent(legacyIDs[1]); err != nil || content != legacyContents[1] {
+			t.Errorf("Expected legacy content readable before migration, err=%v", err)
+		}
+
+		pending, err := versionRepo.MigrateToDeltaStorage(2, true)
+		if err != nil || pending < len(legacyContents) {
+			t.Fatalf("Expected dry run to report at least %d rows, got %d (err=%v)", len(legacyContents), pending, err)
+		}
+
+		if _, err := versionRepo.MigrateToDeltaStorage(2, false); err != nil {
+			t.Fatalf("Migration failed: %v", err)
+		}
+
+		var remaining int64
+		db.Model(&article.ArticleVersion{}).
+			Where("article_id = ? AND storage_type = ?", legacyArticle.ID, articlePkg.StorageFull).
+			Count(&remaining)
+		if remaining != 0 {
+			t.Errorf("Expected no full rows after migration, got %d", remaining)
+		}
+
+		for i, id := range legacyIDs {
+			content, err := versionRepo.GetContent(id)
+			if err != nil || content != legacyContents[i] {
+				t.Errorf("Legacy v%d mismatch after migration: %q (err=%v)", i+1, content, err)
+			}
+		}
+	})
+}
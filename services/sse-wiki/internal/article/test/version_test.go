@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	articlePkg "terminal-terrace/sse-wiki/internal/article"
+	"terminal-terrace/sse-wiki/internal/dto"
 	"terminal-terrace/sse-wiki/internal/model/article"
 	"terminal-terrace/sse-wiki/internal/testutils"
 )
@@ -276,6 +278,248 @@ func TestGetVersionDiff_Integration(t *testing.T) {
 	}
 }
 
+// TestCompareVersions_Integration 集成测试：任意两个版本之间的差异对比
+func TestCompareVersions_Integration(t *testing.T) {
+	service, db := setupArticleService(t)
+
+	author := testutils.CreateTestUser(db)
+	testModule := testutils.CreateTestModule(db, author.ID)
+	testArticle := testutils.CreateTestArticle(db, testModule.ID, author.ID)
+
+	contents := []string{
+		BaseContentGoTutorial,
+		UserAContentGoTutorial,
+		UserBContentGoTutorial,
+	}
+	var prevID *uint
+	for i, content := range contents {
+		version := &article.ArticleVersion{
+			ArticleID:     testArticle.ID,
+			VersionNumber: i + 1,
+			Content:       content,
+			CommitMessage: "commit",
+			AuthorID:      author.ID,
+			Status:        "published",
+			BaseVersionID: prevID,
+			CreatedAt:     time.Now(),
+		}
+		if err := db.Create(version).Error; err != nil {
+			t.Fatalf("Failed to create version %d: %v", i+1, err)
+		}
+		prevID = &version.ID
+	}
+
+	tests := []struct {
+		name            string
+		from            int
+		to              int
+		expectError     bool
+		expectedAdded   int
+		expectedRemoved int
+	}{
+		{name: "adjacent versions", from: 1, to: 2, expectedAdded: 1, expectedRemoved: 1},
+		{name: "non-adjacent versions", from: 1, to: 3, expectedAdded: 2, expectedRemoved: 2},
+		{name: "reverse direction", from: 3, to: 1, expectedAdded: 2, expectedRemoved: 2},
+		{name: "same version", from: 2, to: 2},
+		{name: "non-existent version returns error", from: 1, to: 99, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.CompareVersions(testArticle.ID, tt.from, tt.to, 3)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result["added_lines"] != tt.expectedAdded {
+				t.Errorf("Expected added_lines=%d, got %v", tt.expectedAdded, result["added_lines"])
+			}
+			if result["removed_lines"] != tt.expectedRemoved {
+				t.Errorf("Expected removed_lines=%d, got %v", tt.expectedRemoved, result["removed_lines"])
+			}
+
+			unified, _ := result["unified_diff"].(string)
+			if tt.expectedAdded+tt.expectedRemoved > 0 && !contains(unified, fmt.Sprintf("--- v%d", tt.from)) {
+				t.Errorf("Expected unified diff header for v%d, got %q", tt.from, unified)
+			}
+			if tt.expectedAdded+tt.expectedRemoved == 0 && unified != "" {
+				t.Errorf("Expected empty unified diff, got %q", unified)
+			}
+		})
+	}
+}
+
+// TestRevertToVersion_Integration 集成测试：回滚到历史版本
+func TestRevertToVersion_Integration(t *testing.T) {
+	// setupRevertFixture 在 fixture 基础上再发布一个 v2，使 v1 成为可回滚的历史版本
+	setupRevertFixture := func(t *testing.T) *ArticleTestFixture {
+		fixture := createArticleFixture(t)
+		v2 := &article.ArticleVersion{
+			ArticleID:     fixture.TestArticle.ID,
+			VersionNumber: 2,
+			Content:       "Updated content",
+			CommitMessage: "Second commit",
+			AuthorID:      fixture.Author.ID,
+			Status:        "published",
+			BaseVersionID: &fixture.BaseVersion.ID,
+			CreatedAt:     time.Now(),
+		}
+		if err := fixture.DB.Create(v2).Error; err != nil {
+			t.Fatalf("Failed to create version 2: %v", err)
+		}
+		fixture.TestArticle.CurrentVersionID = &v2.ID
+		if err := fixture.DB.Save(fixture.TestArticle).Error; err != nil {
+			t.Fatalf("Failed to update article: %v", err)
+		}
+		return fixture
+	}
+
+	t.Run("article admin reverts directly", func(t *testing.T) {
+		fixture := setupRevertFixture(t)
+
+		submission, version, err := fixture.Service.RevertToVersion(
+			fixture.TestArticle.ID, 1, "误删内容", fixture.Author.ID, "",
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if submission != nil {
+			t.Errorf("Expected direct publish, got pending submission")
+		}
+		if version == nil {
+			t.Fatalf("Expected published version")
+		}
+		if version.VersionNumber != 3 {
+			t.Errorf("Expected version number 3, got %d", version.VersionNumber)
+		}
+		if version.Content != fixture.BaseVersion.Content {
+			t.Errorf("Expected reverted content %q, got %q", fixture.BaseVersion.Content, version.Content)
+		}
+		if !contains(version.CommitMessage, "回滚到版本 v1") || !contains(version.CommitMessage, "误删内容") {
+			t.Errorf("Expected revert recorded in commit message, got %q", version.CommitMessage)
+		}
+
+		var updated article.Article
+		fixture.DB.First(&updated, fixture.TestArticle.ID)
+		if updated.CurrentVersionID == nil || *updated.CurrentVersionID != version.ID {
+			t.Errorf("Expected current version to be the revert version")
+		}
+	})
+
+	t.Run("regular user revert goes through review", func(t *testing.T) {
+		fixture := setupRevertFixture(t)
+
+		submission, version, err := fixture.Service.RevertToVersion(
+			fixture.TestArticle.ID, 1, "", fixture.RegularUser.ID, "",
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if version != nil {
+			t.Errorf("Expected no published version for regular user")
+		}
+		if submission == nil || submission.Status != "pending" {
+			t.Fatalf("Expected pending submission, got %+v", submission)
+		}
+
+		var proposed article.ArticleVersion
+		fixture.DB.First(&proposed, submission.ProposedVersionID)
+		if proposed.Content != fixture.BaseVersion.Content {
+			t.Errorf("Expected proposed content %q, got %q", fixture.BaseVersion.Content, proposed.Content)
+		}
+	})
+
+	t.Run("invalid targets are rejected", func(t *testing.T) {
+		fixture := setupRevertFixture(t)
+
+		if _, _, err := fixture.Service.RevertToVersion(fixture.TestArticle.ID, 99, "", fixture.Author.ID, ""); err == nil {
+			t.Errorf("Expected error for non-existent version")
+		}
+		if _, _, err := fixture.Service.RevertToVersion(fixture.TestArticle.ID, 2, "", fixture.Author.ID, ""); err == nil {
+			t.Errorf("Expected error when reverting to the current version")
+		}
+	})
+}
+
+// TestGetArticleBlame_Integration 集成测试：逐行追溯文章内容的最后修改版本
+func TestGetArticleBlame_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+
+	// v1: "Initial content"（fixture 创建，作者 Author）
+	// v2: Author 追加两行
+	_, v2, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       "Initial content\nsecond line\nthird line\n",
+		CommitMessage: "append lines",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.Author.ID, "")
+	if err != nil || v2 == nil {
+		t.Fatalf("Failed to publish v2: %v", err)
+	}
+
+	// v3: 版主修改第二行
+	if err := service.AddCollaborator(fixture.TestArticle.ID, fixture.Author.ID, "", dto.AddCollaboratorRequest{
+		UserID: fixture.ModeratorUser.ID,
+		Role:   "moderator",
+	}); err != nil {
+		t.Fatalf("Failed to add moderator: %v", err)
+	}
+	_, v3, err := service.CreateSubmission(fixture.TestArticle.ID, dto.SubmissionRequest{
+		Content:       "Initial content\nsecond line edited\nthird line\n",
+		CommitMessage: "edit second line",
+		BaseVersionID: v2.ID,
+	}, fixture.ModeratorUser.ID, "")
+	if err != nil || v3 == nil {
+		t.Fatalf("Failed to publish v3: %v", err)
+	}
+
+	result, err := service.GetArticleBlame(fixture.TestArticle.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result["version_number"] != v3.VersionNumber {
+		t.Errorf("Expected current version number %d, got %v", v3.VersionNumber, result["version_number"])
+	}
+
+	lines, ok := result["lines"].([]articlePkg.BlameLine)
+	if !ok || len(lines) != 3 {
+		t.Fatalf("Expected 3 blame lines, got %v", result["lines"])
+	}
+
+	expected := []struct {
+		content       string
+		versionNumber int
+		authorID      uint
+	}{
+		{"Initial content", 1, fixture.Author.ID},
+		{"second line edited", v3.VersionNumber, fixture.ModeratorUser.ID},
+		{"third line", v2.VersionNumber, fixture.Author.ID},
+	}
+	for i, exp := range expected {
+		line := lines[i]
+		if line.LineNumber != i+1 || line.Content != exp.content {
+			t.Errorf("Line %d: expected %q, got #%d %q", i+1, exp.content, line.LineNumber, line.Content)
+		}
+		if line.VersionNumber != exp.versionNumber || line.AuthorID != exp.authorID {
+			t.Errorf("Line %d: expected v%d by %d, got v%d by %d",
+				i+1, exp.versionNumber, exp.authorID, line.VersionNumber, line.AuthorID)
+		}
+	}
+
+	t.Run("non-existent article returns error", func(t *testing.T) {
+		if _, err := service.GetArticleBlame(999999); err == nil {
+			t.Errorf("Expected error for non-existent article")
+		}
+	})
+}
+
 // TestGetReviews_Integration 集成测试：获取审核列表
 func TestGetReviews_Integration(t *testing.T) {
 	service, db := setupArticleService(t)
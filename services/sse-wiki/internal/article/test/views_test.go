@@ -0,0 +1,173 @@
+package article_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	articlePkg "terminal-terrace/sse-wiki/internal/article"
+	"terminal-terrace/sse-wiki/internal/database"
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+// currentViewPeriod 当前的阅读去重周期（30 天）
+func currentViewPeriod() int64 {
+	return time.Now().Unix() / int64(30*24*time.Hour/time.Second)
+}
+
+// uniqueViewersKey 文章当前周期的访客 HyperLogLog
+func uniqueViewersKey(articleID uint) string {
+	return fmt.Sprintf("article:views:uv:%d:%d", articleID, currentViewPeriod())
+}
+
+func TestRecordArticleView_Integration(t *testing.T) {
+	redisClient := testutils.SetupTestRedis(t)
+	if redisClient == nil {
+		t.Skip("Redis not available, skipping view counting tests")
+	}
+	previous := database.RedisDB
+	database.RedisDB = redisClient
+	t.Cleanup(func() { database.RedisDB = previous })
+
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+	articleID := fixture.TestArticle.ID
+
+	viewCount := func() uint {
+		result, err := service.GetArticle(articleID, fixture.RegularUser.ID, "")
+		if err != nil {
+			t.Fatalf("GetArticle failed: %v", err)
+		}
+		return result["view_count"].(uint)
+	}
+	storedCount := func() uint {
+		var art article.Article
+		db.First(&art, articleID)
+		return art.ViewCount
+	}
+
+	// 登录用户按用户ID去重，匿名读者按客户端指纹去重
+	service.RecordArticleView(articleID, fixture.RegularUser.ID, "")
+	service.RecordArticleView(articleID, fixture.RegularUser.ID, "device-a")
+	service.RecordArticleView(articleID, 0, "device-a")
+	service.RecordArticleView(articleID, 0, "device-a")
+	service.RecordArticleView(articleID, 0, "device-b")
+	service.RecordArticleView(articleID, 0, "")
+
+	t.Run("buffered until flushed", func(t *testing.T) {
+		if got := storedCount(); got != 0 {
+			t.Errorf("Expected no database writes before flush, got %d", got)
+		}
+		if got := viewCount(); got != 3 {
+			t.Errorf("Expected 3 views including buffered ones, got %d", got)
+		}
+	})
+
+	t.Run("flush writes buffered counts", func(t *testing.T) {
+		if _, err := service.FlushViewCounts(); err != nil {
+			t.Fatalf("FlushViewCounts failed: %v", err)
+		}
+		if got := storedCount(); got != 3 {
+			t.Errorf("Expected 3 stored views, got %d", got)
+		}
+		if got := viewCount(); got != 3 {
+			t.Errorf("Expected buffer to be cleared after flush, got %d", got)
+		}
+
+		service.RecordArticleView(articleID, 0, "device-c")
+		if _, err := service.FlushViewCounts(); err != nil {
+			t.Fatalf("FlushViewCounts failed: %v", err)
+		}
+		if got := storedCount(); got != 4 {
+			t.Errorf("Expected 4 stored views, got %d", got)
+		}
+	})
+
+	ctx := context.Background()
+	const flushingKey = "article:views:flushing"
+	const lockKey = "article:views:flush-lock"
+
+	t.Run("batch committed before cleanup failed is not applied twice", func(t *testing.T) {
+		// 模拟上一轮事务已提交但 Redis 中的批次未删除
+		batchID := fmt.Sprintf("test-batch-%d", articleID)
+		field := fmt.Sprintf("%d:%d", articleID, currentViewPeriod())
+		visitors := redisClient.PFCount(ctx, uniqueViewersKey(articleID)).Val()
+		db.Create(&article.ViewFlushBatch{BatchID: batchID, CreatedAt: time.Now()})
+		redisClient.HSet(ctx, flushingKey, "batch", batchID, "d:"+field, 5, "c:"+field, visitors)
+
+		if _, err := service.FlushViewCounts(); err != nil {
+			t.Fatalf("FlushViewCounts failed: %v", err)
+		}
+		if got := storedCount(); got != 4 {
+			t.Errorf("Expected committed batch to be skipped, got %d stored views", got)
+		}
+		if n, _ := redisClient.Exists(ctx, flushingKey).Result(); n != 0 {
+			t.Errorf("Expected committed batch to be removed from Redis")
+		}
+	})
+
+	t.Run("lock held by another instance is left untouched", func(t *testing.T) {
+		redisClient.Set(ctx, lockKey, "other-instance", time.Minute)
+		t.Cleanup(func() { redisClient.Del(ctx, lockKey) })
+
+		service.RecordArticleView(articleID, 0, "device-d")
+		if _, err := service.FlushViewCounts(); err != nil {
+			t.Fatalf("FlushViewCounts failed: %v", err)
+		}
+		if got := storedCount(); got != 4 {
+			t.Errorf("Expected no write while another instance holds the lock, got %d", got)
+		}
+		if owner, _ := redisClient.Get(ctx, lockKey).Result(); owner != "other-instance" {
+			t.Errorf("Expected lock of another instance to be kept, got %q", owner)
+		}
+	})
+}
+
+// TestViewCountLargeAudience_Integration 访客数远超 HyperLogLog 寄存器数（16384）时阅读量仍与 PFCOUNT 一致
+func TestViewCountLargeAudience_Integration(t *testing.T) {
+	redisClient := testutils.SetupTestRedis(t)
+	if redisClient == nil {
+		t.Skip("Redis not available, skipping view counting tests")
+	}
+	previous := database.RedisDB
+	database.RedisDB = redisClient
+	t.Cleanup(func() { database.RedisDB = previous })
+
+	fixture := createArticleFixture(t)
+	repo := articlePkg.NewArticleRepository(fixture.DB)
+	articleID := fixture.TestArticle.ID
+	ctx := context.Background()
+
+	const visitors = 40000
+	for i := 0; i < visitors; i++ {
+		if err := repo.IncrementViewCount(articleID, fmt.Sprintf("a:visitor-%d", i)); err != nil {
+			t.Fatalf("IncrementViewCount failed: %v", err)
+		}
+		// 中途写入一次，验证增量按上次写入的访客数计算
+		if i == visitors/2 {
+			if _, err := repo.FlushPendingViews(); err != nil {
+				t.Fatalf("FlushPendingViews failed: %v", err)
+			}
+		}
+	}
+	if _, err := repo.FlushPendingViews(); err != nil {
+		t.Fatalf("FlushPendingViews failed: %v", err)
+	}
+
+	var art article.Article
+	fixture.DB.First(&art, articleID)
+	estimated := redisClient.PFCount(ctx, uniqueViewersKey(articleID)).Val()
+	if int64(art.ViewCount) != estimated {
+		t.Errorf("Expected flushed view_count to equal PFCOUNT %d, got %d", estimated, art.ViewCount)
+	}
+	if math.Abs(float64(art.ViewCount)-visitors)/visitors > 0.03 {
+		t.Errorf("Expected about %d views, got %d", visitors, art.ViewCount)
+	}
+	if pending := repo.PendingViewCount(articleID); pending != 0 {
+		t.Errorf("Expected no pending views after flush, got %d", pending)
+	}
+}
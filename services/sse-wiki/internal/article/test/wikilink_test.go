@@ -0,0 +1,97 @@
+package article_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terminal-terrace/sse-wiki/internal/dto"
+	"terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/testutils"
+)
+
+func TestWikiLinkExtraction_Integration(t *testing.T) {
+	fixture := createArticleFixture(t)
+	service := fixture.Service
+	db := fixture.DB
+
+	source := fixture.TestArticle
+	byTitle := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID, testutils.WithTitle("Graph Theory Basics"))
+	byURL := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+	manual := testutils.CreateTestArticle(db, fixture.TestModule.ID, fixture.Author.ID)
+
+	if _, err := service.AddReference(source.ID, manual.ID, "prerequisite", fixture.Author.ID); err != nil {
+		t.Fatalf("AddReference failed: %v", err)
+	}
+
+	autoRefs := func() map[uint]article.ArticleReference {
+		var refs []article.ArticleReference
+		db.Where("from_article_id = ?", source.ID).Find(&refs)
+		result := make(map[uint]article.ArticleReference, len(refs))
+		for _, ref := range refs {
+			result[ref.ToArticleID] = ref
+		}
+		return result
+	}
+
+	// 1. 直接发布时提取 [[标题]] 和 /articles/<id> 链接
+	content := fmt.Sprintf("See [[Graph Theory Basics|basics]] and [next](/articles/%d).\n"+
+		"Also [[Missing Article]] and /articles/999999.\n"+
+		"```\n[[%s]]\n```\n"+
+		"Manual: /articles/%d", byURL.ID, manual.Title, manual.ID)
+	_, version, err := service.CreateSubmission(source.ID, dto.SubmissionRequest{
+		Content:       content,
+		CommitMessage: "add links",
+		BaseVersionID: fixture.BaseVersion.ID,
+	}, fixture.Author.ID, "")
+	if err != nil {
+		t.Fatalf("CreateSubmission failed: %v", err)
+	}
+
+	refs := autoRefs()
+	if len(refs) != 3 {
+		t.Fatalf("Expected 3 references, got %d: %+v", len(refs), refs)
+	}
+	for _, id := range []uint{byTitle.ID, byURL.ID} {
+		if ref, ok := refs[id]; !ok || ref.ReferenceType != "related" || !ref.IsAuto {
+			t.Errorf("Expected auto related reference to %d, got %+v", id, ref)
+		}
+	}
+	if ref := refs[manual.ID]; ref.ReferenceType != "prerequisite" || ref.IsAuto {
+		t.Errorf("Expected manual reference to be kept, got %+v", ref)
+	}
+
+	result, err := service.GetArticleReferences(byTitle.ID, "")
+	if err != nil {
+		t.Fatalf("GetArticleReferences failed: %v", err)
+	}
+	if backlinks := result["backlinks"].([]map[string]interface{}); len(backlinks) != 1 || backlinks[0]["article_id"] != source.ID {
+		t.Errorf("Expected source as backlink, got %+v", backlinks)
+	}
+
+	// 2. 审核通过时同步：删除的链接被移除，手动引用保持不变
+	if err := db.Model(&article.Article{}).Where("id = ?", source.ID).Update("is_review_required", true).Error; err != nil {
+		t.Fatalf("Failed to enable review: %v", err)
+	}
+	submission, _, err := service.CreateSubmission(source.ID, dto.SubmissionRequest{
+		Content:       "Only [[Graph Theory Basics]] now",
+		CommitMessage: "drop links",
+		BaseVersionID: version.ID,
+	}, fixture.RegularUser.ID, "")
+	if err != nil || submission == nil {
+		t.Fatalf("Expected pending submission, err=%v", err)
+	}
+	if _, err := service.ReviewSubmission(submission.ID, fixture.Author.ID, "", dto.ReviewActionRequest{Action: "approve"}); err != nil {
+		t.Fatalf("ReviewSubmission failed: %v", err)
+	}
+
+	refs = autoRefs()
+	if _, ok := refs[byURL.ID]; ok {
+		t.Errorf("Expected removed link to be dropped")
+	}
+	if _, ok := refs[byTitle.ID]; !ok {
+		t.Errorf("Expected title link to be kept")
+	}
+	if ref, ok := refs[manual.ID]; !ok || ref.IsAuto {
+		t.Errorf("Expected manual reference to survive sync")
+	}
+}
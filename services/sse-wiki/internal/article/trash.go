@@ -0,0 +1,162 @@
+package article
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// DefaultTrashRetention 回收站默认保留期限
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// trashRetention 回收站保留期限，超过期限的文章由 PurgeExpiredTrash 永久删除
+var trashRetention = DefaultTrashRetention
+
+// SetTrashRetention 设置回收站保留期限（非正数时使用默认值）
+func SetTrashRetention(retention time.Duration) {
+	if retention <= 0 {
+		retention = DefaultTrashRetention
+	}
+	trashRetention = retention
+}
+
+// purgeBatchSize 每轮清理的最大文章数
+const purgeBatchSize = 100
+
+// ListTrash 获取模块（含子模块）回收站中的文章
+// 权限要求：模块 admin 及以上（含从祖先模块继承的权限）
+func (s *ArticleService) ListTrash(moduleID uint, userID uint, userRole string, page, pageSize int) (map[string]interface{}, error) {
+	if !s.articleRepo.ModuleExists(moduleID) {
+		return nil, errors.New("模块不存在")
+	}
+	if !s.permissionService.CheckModulePermissionWithInheritance(moduleID, userID, userRole, "admin").HasPermission {
+		return nil, errors.New("permission denied: only module admins can manage the trash")
+	}
+
+	moduleIDs, err := s.articleRepo.GetModuleSubtreeIDs(moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * pageSize
+	articles, total, err := s.articleRepo.ListTrash(moduleIDs, offset, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]interface{}, len(articles))
+	for i, art := range articles {
+		items[i] = map[string]interface{}{
+			"id":         art.ID,
+			"title":      art.Title,
+			"module_id":  art.ModuleID,
+			"created_by": art.CreatedBy,
+			"deleted_by": art.DeletedBy,
+			"deleted_at": art.DeletedAt.Time,
+			"purge_at":   art.DeletedAt.Time.Add(trashRetention),
+		}
+	}
+
+	return map[string]interface{}{
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+		"articles":  items,
+	}, nil
+}
+
+// RestoreArticle 从回收站恢复文章，关联数据随文章一起恢复可见
+// 权限要求：文章所在模块的 admin 及以上
+func (s *ArticleService) RestoreArticle(articleID uint, userID uint, userRole string) error {
+	art, err := s.getTrashedArticleForAdmin(articleID, userID, userRole)
+	if err != nil {
+		return err
+	}
+
+	if err := s.articleRepo.Restore(art.ID); err != nil {
+		return err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[RestoreArticle] 文章已恢复, articleID=%d, restoredBy=%d", articleID, userID)
+	return nil
+}
+
+// PurgeArticle 永久删除回收站中的文章及其所有关联数据
+// 权限要求：文章所在模块的 admin 及以上
+func (s *ArticleService) PurgeArticle(articleID uint, userID uint, userRole string) error {
+	art, err := s.getTrashedArticleForAdmin(articleID, userID, userRole)
+	if err != nil {
+		return err
+	}
+
+	if err := s.articleRepo.PurgeArticleWithCascade(art.ID); err != nil {
+		return err
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[PurgeArticle] 文章已永久删除, articleID=%d, purgedBy=%d", articleID, userID)
+	return nil
+}
+
+// PurgeExpiredTrash 永久删除超过保留期限的回收站文章，返回删除数量
+func (s *ArticleService) PurgeExpiredTrash() (int, error) {
+	before := time.Now().Add(-trashRetention)
+	purged := 0
+	for {
+		ids, err := s.articleRepo.ListExpiredTrash(before, purgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+		if len(ids) == 0 {
+			return purged, nil
+		}
+		for _, id := range ids {
+			if err := s.articleRepo.PurgeArticleWithCascade(id); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+	}
+}
+
+// StartTrashPurgeJob 启动回收站定期清理任务，返回停止函数
+func StartTrashPurgeJob(service *ArticleService, interval time.Duration) func() {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				purged, err := service.PurgeExpiredTrash()
+				if err != nil {
+					// TODO: 生产环境优化 - 移除或使用结构化日志
+					log.Printf("[TrashPurgeJob] 清理回收站失败（已删除 %d 篇）: %v", purged, err)
+				} else if purged > 0 {
+					// TODO: 生产环境优化 - 移除或使用结构化日志
+					log.Printf("[TrashPurgeJob] 已永久删除 %d 篇过期文章", purged)
+				}
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+// getTrashedArticleForAdmin 获取回收站中的文章并检查模块管理员权限
+func (s *ArticleService) getTrashedArticleForAdmin(articleID uint, userID uint, userRole string) (*article.Article, error) {
+	art, err := s.articleRepo.GetTrashedByID(articleID)
+	if err != nil {
+		return nil, errors.New("回收站中不存在该文章")
+	}
+	if !s.permissionService.CheckModulePermissionWithInheritance(art.ModuleID, userID, userRole, "admin").HasPermission {
+		return nil, errors.New("permission denied: only module admins can manage the trash")
+	}
+	return art, nil
+}
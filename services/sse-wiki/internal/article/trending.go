@@ -0,0 +1,100 @@
+package article
+
+import (
+	"errors"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// trendingWindow 热门排行的统计窗口
+type trendingWindow struct {
+	hours         int     // 统计最近多少小时的阅读
+	halfLifeHours float64 // 阅读热度衰减一半所需的小时数
+}
+
+// trendingWindows 支持的统计窗口（默认 week）
+var trendingWindows = map[string]trendingWindow{
+	"day":   {hours: 24, halfLifeHours: 6},
+	"week":  {hours: 7 * 24, halfLifeHours: 24},
+	"month": {hours: 30 * 24, halfLifeHours: 72},
+}
+
+// trendingScanBatch 按模块过滤时每批扫描的排行条目数
+const trendingScanBatch = 100
+
+// GetTrendingArticles 获取热门文章排行
+// 热度为统计窗口内每小时阅读数按时间衰减后的和，近期阅读权重更高；已删除的文章不返回
+// moduleID 非 0 时只返回该模块及其子模块中的文章
+func (s *ArticleService) GetTrendingArticles(window string, moduleID uint, limit int) (map[string]interface{}, error) {
+	if window == "" {
+		window = "week"
+	}
+	conf, ok := trendingWindows[window]
+	if !ok {
+		return nil, errors.New("无效的统计窗口")
+	}
+
+	var inSubtree map[uint]bool
+	if moduleID != 0 {
+		if !s.articleRepo.ModuleExists(moduleID) {
+			return nil, errors.New("模块不存在")
+		}
+		ids, err := s.articleRepo.GetModuleSubtreeIDs(moduleID)
+		if err != nil {
+			return nil, err
+		}
+		inSubtree = make(map[uint]bool, len(ids))
+		for _, id := range ids {
+			inSubtree[id] = true
+		}
+	}
+
+	var ordered []article.Article
+	var scores []TrendingScore
+	for offset := 0; len(ordered) < limit; offset += trendingScanBatch {
+		batch, err := s.articleRepo.ListTrending(window, conf.hours, conf.halfLifeHours, offset, trendingScanBatch)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]uint, len(batch))
+		for i, score := range batch {
+			ids[i] = score.ArticleID
+		}
+		articles, err := s.articleRepo.GetByIDs(ids)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[uint]article.Article, len(articles))
+		for _, art := range articles {
+			byID[art.ID] = art
+		}
+
+		for _, score := range batch {
+			art, ok := byID[score.ArticleID]
+			if !ok || (inSubtree != nil && !inSubtree[art.ModuleID]) {
+				continue
+			}
+			ordered = append(ordered, art)
+			scores = append(scores, score)
+			if len(ordered) == limit {
+				break
+			}
+		}
+
+		if len(batch) < trendingScanBatch {
+			break
+		}
+	}
+
+	items := s.buildArticleListItems(ordered)
+	for i, item := range items {
+		item["trending_score"] = scores[i].Score
+		item["window_views"] = scores[i].Views
+	}
+
+	return map[string]interface{}{
+		"window":   window,
+		"articles": items,
+	}, nil
+}
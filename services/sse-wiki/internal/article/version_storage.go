@@ -0,0 +1,271 @@
+package article
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+// 版本内容存储方式
+const (
+	StorageFull     = "full"     // 未压缩原文（迁移前的历史数据）
+	StorageSnapshot = "snapshot" // 压缩后的完整快照
+	StorageDelta    = "delta"    // 相对父版本的压缩增量
+)
+
+// maxDeltaDepth 增量链最大长度，超过后写入新快照，限制还原时需要回放的增量数量
+const maxDeltaDepth = 10
+
+// deltaOp 增量操作：Count > 0 时从父版本第 Start 行（从0开始）起复制 Count 行，否则插入 Insert 文本
+type deltaOp struct {
+	Start  int    `json:"s,omitempty"`
+	Count  int    `json:"n,omitempty"`
+	Insert string `json:"i,omitempty"`
+}
+
+// encodeContent 在写入数据库前压缩版本内容
+// 优先以父版本（MergedAgainstVersionID，否则 BaseVersionID）为基础写入增量，
+// 父版本不存在、未发布、增量链过长或增量不比快照小时写入快照。编码后 Content 置空，由调用方在写入后恢复
+// 只有已发布版本的内容不再变化，待审核等版本可能被原地改写，以其为基础的增量会失效
+func (r *VersionRepository) encodeContent(version *article.ArticleVersion) error {
+	snapshot, err := gzipBytes([]byte(version.Content))
+	if err != nil {
+		return err
+	}
+
+	version.StorageType = StorageSnapshot
+	version.StoredContent = snapshot
+	version.DeltaBaseVersionID = nil
+	version.DeltaDepth = 0
+
+	baseID := version.MergedAgainstVersionID
+	if baseID == nil {
+		baseID = version.BaseVersionID
+	}
+	if baseID != nil && *baseID != version.ID {
+		var base article.ArticleVersion
+		if err := r.db.First(&base, *baseID).Error; err == nil && base.ArticleID == version.ArticleID && base.Status == "published" && base.DeltaDepth < maxDeltaDepth {
+			baseContent, err := r.resolveContent(&base, map[uint]string{})
+			if err != nil {
+				return err
+			}
+			delta, err := encodeDelta(baseContent, version.Content)
+			if err != nil {
+				return err
+			}
+			if len(delta) < len(snapshot) {
+				version.StorageType = StorageDelta
+				version.StoredContent = delta
+				version.DeltaBaseVersionID = &base.ID
+				version.DeltaDepth = base.DeltaDepth + 1
+			}
+		}
+	}
+
+	version.Content = ""
+	return nil
+}
+
+// materialize 还原版本内容到 Content 字段
+func (r *VersionRepository) materialize(versions ...*article.ArticleVersion) error {
+	cache := make(map[uint]string)
+	for _, v := range versions {
+		content, err := r.resolveContent(v, cache)
+		if err != nil {
+			return err
+		}
+		v.Content = content
+	}
+	return nil
+}
+
+// resolveContent 计算版本的完整内容，cache 用于在一次读取中复用已还原的父版本
+func (r *VersionRepository) resolveContent(v *article.ArticleVersion, cache map[uint]string) (string, error) {
+	if content, ok := cache[v.ID]; ok {
+		return content, nil
+	}
+
+	var content string
+	switch v.StorageType {
+	case "", StorageFull:
+		content = v.Content
+	case StorageSnapshot:
+		data, err := gunzipBytes(v.StoredContent)
+		if err != nil {
+			return "", fmt.Errorf("版本 %d 快照解压失败: %w", v.ID, err)
+		}
+		content = string(data)
+	case StorageDelta:
+		if v.DeltaBaseVersionID == nil {
+			return "", fmt.Errorf("版本 %d 缺少增量基础版本", v.ID)
+		}
+		// 同一次读取中已还原的基础版本直接复用，不再查询
+		baseContent, ok := cache[*v.DeltaBaseVersionID]
+		if !ok {
+			var base article.ArticleVersion
+			if err := r.db.First(&base, *v.DeltaBaseVersionID).Error; err != nil {
+				return "", fmt.Errorf("版本 %d 的增量基础版本不存在: %w", v.ID, err)
+			}
+			var err error
+			if baseContent, err = r.resolveContent(&base, cache); err != nil {
+				return "", err
+			}
+		}
+		var err error
+		content, err = applyDelta(baseContent, v.StoredContent)
+		if err != nil {
+			return "", fmt.Errorf("版本 %d 增量还原失败: %w", v.ID, err)
+		}
+	default:
+		return "", fmt.Errorf("版本 %d 存储方式未知: %s", v.ID, v.StorageType)
+	}
+
+	cache[v.ID] = content
+	return content, nil
+}
+
+// MigrateToDeltaStorage 将 full 方式存储的历史版本转换为快照+增量存储
+// 按文章、版本号升序处理，保证父版本先于子版本转换；dryRun 时只统计不写入
+// 返回转换（或将要转换）的版本数量
+func (r *VersionRepository) MigrateToDeltaStorage(batchSize int, dryRun bool) (int, error) {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	const fullRows = "storage_type = ? OR storage_type IS NULL OR storage_type = ''"
+
+	if dryRun {
+		var total int64
+		err := r.db.Model(&article.ArticleVersion{}).Where(fullRows, StorageFull).Count(&total).Error
+		return int(total), err
+	}
+
+	var articleIDs []uint
+	if err := r.db.Model(&article.ArticleVersion{}).
+		Where(fullRows, StorageFull).
+		Distinct().
+		Order("article_id").
+		Pluck("article_id", &articleIDs).Error; err != nil {
+		return 0, err
+	}
+
+	converted := 0
+	for _, articleID := range articleIDs {
+		// 每批转换后这些行不再满足条件，重复查询直到没有剩余
+		for {
+			var versions []article.ArticleVersion
+			err := r.db.Where("article_id = ?", articleID).
+				Where(fullRows, StorageFull).
+				Order("version_number ASC").
+				Limit(batchSize).
+				Find(&versions).Error
+			if err != nil {
+				return converted, err
+			}
+			if len(versions) == 0 {
+				break
+			}
+
+			for i := range versions {
+				if err := r.Update(&versions[i]); err != nil {
+					return converted, fmt.Errorf("转换版本 %d 失败: %w", versions[i].ID, err)
+				}
+				converted++
+			}
+		}
+	}
+
+	return converted, nil
+}
+
+// encodeDelta 计算 base -> target 的行级增量并压缩
+func encodeDelta(base, target string) ([]byte, error) {
+	baseLines := splitLines(base)
+	targetLines := splitLines(target)
+
+	var ops []deltaOp
+	var insert strings.Builder
+	flushInsert := func() {
+		if insert.Len() > 0 {
+			ops = append(ops, deltaOp{Insert: insert.String()})
+			insert.Reset()
+		}
+	}
+
+	j := 0
+	for _, m := range matchLines(baseLines, targetLines) {
+		for ; j < m.b; j++ {
+			insert.WriteString(targetLines[j])
+		}
+		flushInsert()
+		// 与上一个复制操作连续时直接扩展
+		if n := len(ops); n > 0 && ops[n-1].Count > 0 && ops[n-1].Start+ops[n-1].Count == m.a {
+			ops[n-1].Count++
+		} else {
+			ops = append(ops, deltaOp{Start: m.a, Count: 1})
+		}
+		j++
+	}
+	for ; j < len(targetLines); j++ {
+		insert.WriteString(targetLines[j])
+	}
+	flushInsert()
+
+	data, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+	return gzipBytes(data)
+}
+
+// applyDelta 将压缩增量应用到 base 上得到目标内容
+func applyDelta(base string, delta []byte) (string, error) {
+	data, err := gunzipBytes(delta)
+	if err != nil {
+		return "", err
+	}
+	var ops []deltaOp
+	if err := json.Unmarshal(data, &ops); err != nil {
+		return "", err
+	}
+
+	baseLines := splitLines(base)
+	var sb strings.Builder
+	for _, op := range ops {
+		if op.Count == 0 {
+			sb.WriteString(op.Insert)
+			continue
+		}
+		if op.Start < 0 || op.Start+op.Count > len(baseLines) {
+			return "", errors.New("增量数据与基础版本不匹配")
+		}
+		writeLines(&sb, baseLines[op.Start:op.Start+op.Count])
+	}
+	return sb.String(), nil
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipBytes(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,80 @@
+package article
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+)
+
+// DefaultViewFlushInterval 阅读量缓冲写入数据库的默认间隔
+const DefaultViewFlushInterval = 30 * time.Second
+
+// visitorID 阅读去重使用的访客标识
+// 登录用户使用用户ID；匿名读者使用客户端指纹（哈希后使用，避免原始信息进入 Redis）；两者都没有时返回空
+func visitorID(userID uint, fingerprint string) string {
+	if userID != 0 {
+		return fmt.Sprintf("u:%d", userID)
+	}
+	if fingerprint == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(fingerprint))
+	return "a:" + hex.EncodeToString(sum[:16])
+}
+
+// RecordArticleView 记录一次文章阅读（更新阅读量和热度统计）
+// 同一访客 30 天内只计一次阅读量；无法识别的匿名请求（无用户ID且无客户端指纹）不计数
+func (s *ArticleService) RecordArticleView(articleID uint, userID uint, fingerprint string) {
+	visitor := visitorID(userID, fingerprint)
+	if visitor == "" {
+		return
+	}
+	if err := s.articleRepo.IncrementViewCount(articleID, visitor); err != nil {
+		// TODO: 生产环境优化 - 移除或使用结构化日志
+		log.Printf("[RecordArticleView] 记录阅读失败, articleID=%d, err=%v", articleID, err)
+	}
+}
+
+// FlushViewCounts 将 Redis 中缓冲的阅读量和每日阅读统计写入数据库，返回更新阅读量的文章数
+func (s *ArticleService) FlushViewCounts() (int, error) {
+	flushed, err := s.articleRepo.FlushPendingViews()
+	if err != nil {
+		return flushed, err
+	}
+	return flushed, s.articleRepo.FlushDailyStats()
+}
+
+// StartViewFlushJob 启动阅读量定期写入任务，返回停止函数（停止前会再写入一次）
+func StartViewFlushJob(service *ArticleService, interval time.Duration) func() {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	flush := func() {
+		if _, err := service.FlushViewCounts(); err != nil {
+			// TODO: 生产环境优化 - 移除或使用结构化日志
+			log.Printf("[ViewFlushJob] 写入阅读量失败: %v", err)
+		}
+	}
+
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-ticker.C:
+				flush()
+			case <-done:
+				ticker.Stop()
+				flush()
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
@@ -0,0 +1,123 @@
+package article
+
+import (
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"terminal-terrace/sse-wiki/internal/model/article"
+)
+
+var (
+	// [[标题]]、[[标题|显示文本]]、[[标题#章节]]
+	wikiTitleLinkPattern = regexp.MustCompile(`\[\[([^\[\]|#\n]+)(?:[|#][^\[\]\n]*)?\]\]`)
+	// /articles/<id>，包括完整 URL 和 /modules/<mid>/articles/<id> 形式
+	articleURLPattern = regexp.MustCompile(`/articles/(\d+)\b`)
+)
+
+// scanContentLines 逐行扫描正文，跳过代码块（``` 包围的内容），lineNo 从1开始
+func scanContentLines(content string, fn func(lineNo int, line string)) {
+	inCodeBlock := false
+	for i, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if !inCodeBlock {
+			fn(i+1, line)
+		}
+	}
+}
+
+// extractWikiLinks 从正文中提取内部链接，返回按出现顺序去重的标题和文章ID
+// 代码块中的链接会被忽略
+func extractWikiLinks(content string) (titles []string, articleIDs []uint) {
+	seenTitles := make(map[string]bool)
+	seenIDs := make(map[uint]bool)
+
+	scanContentLines(content, func(_ int, line string) {
+		for _, m := range wikiTitleLinkPattern.FindAllStringSubmatch(line, -1) {
+			title := strings.TrimSpace(m[1])
+			if title != "" && !seenTitles[title] {
+				seenTitles[title] = true
+				titles = append(titles, title)
+			}
+		}
+		for _, m := range articleURLPattern.FindAllStringSubmatch(line, -1) {
+			id, err := strconv.ParseUint(m[1], 10, 64)
+			if err != nil || id == 0 || seenIDs[uint(id)] {
+				continue
+			}
+			seenIDs[uint(id)] = true
+			articleIDs = append(articleIDs, uint(id))
+		}
+	})
+	return titles, articleIDs
+}
+
+// syncWikiLinks 在文章发布新版本后，将正文中的内部链接同步为 related 引用
+// 链接指向不存在或回收站中的文章时忽略；同步失败只记录日志，不影响发布
+func (s *ArticleService) syncWikiLinks(art *article.Article, content string) {
+	targetIDs, err := s.resolveWikiLinks(art, content)
+	if err == nil {
+		err = s.articleRepo.SyncAutoReferences(art.ID, targetIDs)
+	}
+	if err != nil {
+		// TODO: 生产环境优化 - 移除或使用结构化日志
+		log.Printf("[syncWikiLinks] 同步内部链接失败, articleID=%d, err=%v", art.ID, err)
+		return
+	}
+
+	// TODO: 生产环境优化 - 移除或使用结构化日志
+	log.Printf("[syncWikiLinks] 同步内部链接完成, articleID=%d, links=%d", art.ID, len(targetIDs))
+}
+
+// resolveWikiLinks 将正文中的链接解析为文章ID
+// [[标题]] 按标题精确匹配，同名文章优先选择同模块的，其次选择最早创建的
+func (s *ArticleService) resolveWikiLinks(art *article.Article, content string) ([]uint, error) {
+	titles, linkedIDs := extractWikiLinks(content)
+
+	seen := map[uint]bool{art.ID: true}
+	var targetIDs []uint
+	add := func(id uint) {
+		if !seen[id] {
+			seen[id] = true
+			targetIDs = append(targetIDs, id)
+		}
+	}
+
+	for _, title := range titles {
+		candidates, err := s.articleRepo.FindByTitle(title)
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		target := candidates[0]
+		for _, c := range candidates {
+			if c.ModuleID == art.ModuleID {
+				target = c
+				break
+			}
+		}
+		add(target.ID)
+	}
+
+	existing, err := s.articleRepo.GetByIDs(linkedIDs)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[uint]bool, len(existing))
+	for _, a := range existing {
+		exists[a.ID] = true
+	}
+	for _, id := range linkedIDs {
+		if exists[id] {
+			add(id)
+		}
+	}
+
+	return targetIDs, nil
+}
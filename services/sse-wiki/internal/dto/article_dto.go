@@ -42,6 +42,8 @@ type UpdateArticleBasicInfoRequest struct {
 	Title            *string      `json:"title" binding:"omitempty,max=255"`
 	Tags             *StringSlice `json:"tags"`
 	IsReviewRequired *bool        `json:"is_review_required"`
+	// 审核通过所需人数，0 表示继承模块设置
+	RequiredApprovals *int `json:"required_approvals" binding:"omitempty,min=0"`
 }
 
 // AddCollaboratorRequest 添加协作者请求
@@ -66,13 +68,46 @@ type SubmissionRequest struct {
 	BaseVersionID uint   `json:"base_version_id" binding:"required"`
 }
 
+// SaveDraftRequest 保存草稿请求
+// BaseVersionID 为 0 时沿用已有草稿的基础版本，新草稿则以文章当前版本为基础
+type SaveDraftRequest struct {
+	Content       string `json:"content"`
+	CommitMessage string `json:"commit_message" binding:"max=255"`
+	BaseVersionID uint   `json:"base_version_id"`
+}
+
+// UpdateSubmissionRequest 提交者更新（变基）提交请求
+// BaseVersionID 必须是文章当前线上版本，为 0 时默认使用当前版本
+type UpdateSubmissionRequest struct {
+	Content       string `json:"content" binding:"required"`
+	CommitMessage string `json:"commit_message" binding:"max=255"`
+	BaseVersionID uint   `json:"base_version_id"`
+}
+
+// ReviseSubmissionRequest 提交者推送新修订请求（审核人要求修改后）
+// BaseVersionID 为 0 时沿用原基础版本，否则必须是文章当前版本
+type ReviseSubmissionRequest struct {
+	Content       string `json:"content" binding:"required"`
+	CommitMessage string `json:"commit_message" binding:"max=255"`
+	BaseVersionID uint   `json:"base_version_id"`
+}
+
 // ReviewActionRequest 审核操作请求
 type ReviewActionRequest struct {
-	Action        string  `json:"action" binding:"required,oneof=approve reject"`
+	Action        string  `json:"action" binding:"required,oneof=approve reject request_changes"`
 	Notes         string  `json:"notes"`
 	MergedContent *string `json:"merged_content"` // 仅当手动解决冲突时需要
 }
 
+// ReviewCommentRequest 审核行内评论请求
+// 根评论需要指定 proposed 版本的行范围（从1开始，闭区间）；回复指定 ParentID，行范围沿用根评论
+type ReviewCommentRequest struct {
+	Content   string `json:"content" binding:"required"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	ParentID  uint   `json:"parent_id"`
+}
+
 // ArticleResponse 文章响应
 type ArticleResponse struct {
 	ID               uint     `json:"id"`
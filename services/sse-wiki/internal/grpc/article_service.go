@@ -45,8 +45,129 @@ func (s *ArticleServiceImpl) GetArticlesByModule(ctx context.Context, req *pb.Ge
 	total := getInt64(result, "total")
 	resultPage := getInt(result, "page")
 	resultPageSize := getInt(result, "page_size")
-	articlesData := getSlice(result, "articles")
+	articles := convertArticleListItems(getSlice(result, "articles"))
 
+	return &pb.GetArticlesByModuleResponse{
+		Total:    total,
+		Page:     int32(resultPage),
+		PageSize: int32(resultPageSize),
+		Articles: articles,
+	}, nil
+}
+
+// GetArticlesByTags returns articles matching any or all of the given tags across modules
+func (s *ArticleServiceImpl) GetArticlesByTags(ctx context.Context, req *pb.GetArticlesByTagsRequest) (*pb.GetArticlesByTagsResponse, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	result, err := s.getArticleService().GetArticlesByTags(req.Tags, req.MatchAll, uint(req.ModuleId), req.SortBy, page, pageSize)
+	if err != nil {
+		switch err.Error() {
+		case "请至少指定一个标签":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case "模块不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetArticlesByTagsResponse{
+		Total:    getInt64(result, "total"),
+		Page:     int32(getInt(result, "page")),
+		PageSize: int32(getInt(result, "page_size")),
+		Articles: convertArticleListItems(getSlice(result, "articles")),
+	}, nil
+}
+
+// SearchArticles performs full-text search over article titles and current content
+func (s *ArticleServiceImpl) SearchArticles(ctx context.Context, req *pb.SearchArticlesRequest) (*pb.SearchArticlesResponse, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	result, err := s.getArticleService().SearchArticles(req.Query, uint(req.ModuleId), req.Tags, page, pageSize)
+	if err != nil {
+		switch err.Error() {
+		case "搜索关键词不能为空":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case "模块不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items := getSlice(result, "results")
+	articles := convertArticleListItems(items)
+	results := make([]*pb.SearchResult, len(items))
+	for i, item := range items {
+		r, _ := item.(map[string]interface{})
+		rank, _ := r["rank"].(float64)
+		results[i] = &pb.SearchResult{
+			Article:        articles[i],
+			TitleHighlight: getString(r, "title_highlight"),
+			Snippet:        getString(r, "snippet"),
+			Rank:           rank,
+		}
+	}
+
+	return &pb.SearchArticlesResponse{
+		Total:    getInt64(result, "total"),
+		Page:     int32(getInt(result, "page")),
+		PageSize: int32(getInt(result, "page_size")),
+		Results:  results,
+	}, nil
+}
+
+// GetTrendingArticles returns articles ranked by time-decayed view activity
+func (s *ArticleServiceImpl) GetTrendingArticles(ctx context.Context, req *pb.GetTrendingArticlesRequest) (*pb.GetTrendingArticlesResponse, error) {
+	limit := int(req.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	result, err := s.getArticleService().GetTrendingArticles(req.Window, uint(req.ModuleId), limit)
+	if err != nil {
+		switch err.Error() {
+		case "无效的统计窗口":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case "模块不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items := getSlice(result, "articles")
+	listItems := convertArticleListItems(items)
+	articles := make([]*pb.TrendingArticle, len(items))
+	for i, item := range items {
+		a, _ := item.(map[string]interface{})
+		score, _ := a["trending_score"].(float64)
+		articles[i] = &pb.TrendingArticle{
+			Article:     listItems[i],
+			Score:       score,
+			WindowViews: getInt64(a, "window_views"),
+		}
+	}
+
+	return &pb.GetTrendingArticlesResponse{
+		Window:   getString(result, "window"),
+		Articles: articles,
+	}, nil
+}
+
+// convertArticleListItems converts article list maps to protobuf list items
+func convertArticleListItems(articlesData []interface{}) []*pb.ArticleListItem {
 	articles := make([]*pb.ArticleListItem, len(articlesData))
 	for i, item := range articlesData {
 		if a, ok := item.(map[string]interface{}); ok {
@@ -64,13 +185,7 @@ func (s *ArticleServiceImpl) GetArticlesByModule(ctx context.Context, req *pb.Ge
 			}
 		}
 	}
-
-	return &pb.GetArticlesByModuleResponse{
-		Total:    total,
-		Page:     int32(resultPage),
-		PageSize: int32(resultPageSize),
-		Articles: articles,
-	}, nil
+	return articles
 }
 
 // Get User favourites
@@ -105,10 +220,12 @@ func (s *ArticleServiceImpl) GetArticle(ctx context.Context, req *pb.GetArticleR
 	// 从 JWT 获取用户信息
 	user := GetUserFromContext(ctx)
 
-	result, err := s.getArticleService().GetArticle(uint(req.Id), uint(user.UserID), user.Role)
+	articleService := s.getArticleService()
+	result, err := articleService.GetArticle(uint(req.Id), uint(user.UserID), user.Role)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
+	articleService.RecordArticleView(uint(req.Id), uint(user.UserID), ClientFingerprint(ctx))
 
 	pbArticle := &pb.Article{
 		Id:               uint32(getUint(result, "id")),
@@ -167,6 +284,10 @@ func (s *ArticleServiceImpl) GetArticle(ctx context.Context, req *pb.GetArticleR
 					ReviewNotes:            getString(entryMap, "review_notes"),
 					CreatedAt:              getString(entryMap, "created_at"),
 					ReviewedAt:             getString(entryMap, "reviewed_at"),
+					WithdrawnBy:            uint32(getUint(entryMap, "withdrawn_by")),
+					WithdrawnAt:            getString(entryMap, "withdrawn_at"),
+					FromModuleId:           uint32(getUint(entryMap, "from_module_id")),
+					ToModuleId:             uint32(getUint(entryMap, "to_module_id")),
 				}
 			}
 		}
@@ -226,6 +347,84 @@ func (s *ArticleServiceImpl) GetVersionDiff(ctx context.Context, req *pb.GetVers
 	return response, nil
 }
 
+// CompareVersions returns the computed diff between two versions of an article
+func (s *ArticleServiceImpl) CompareVersions(ctx context.Context, req *pb.CompareVersionsRequest) (*pb.CompareVersionsResponse, error) {
+	contextLines := int(req.ContextLines)
+	if contextLines <= 0 {
+		contextLines = 3
+	}
+
+	result, err := s.getArticleService().CompareVersions(uint(req.ArticleId), int(req.FromVersion), int(req.ToVersion), contextLines)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	response := &pb.CompareVersionsResponse{
+		UnifiedDiff:  getString(result, "unified_diff"),
+		AddedLines:   int32(getInt(result, "added_lines")),
+		RemovedLines: int32(getInt(result, "removed_lines")),
+	}
+	if v, ok := result["from_version"].(*articleModel.ArticleVersion); ok && v != nil {
+		response.FromVersion = convertArticleVersion(v)
+	}
+	if v, ok := result["to_version"].(*articleModel.ArticleVersion); ok && v != nil {
+		response.ToVersion = convertArticleVersion(v)
+	}
+
+	if hunks, ok := result["hunks"].([]article.DiffHunk); ok {
+		response.Hunks = make([]*pb.DiffHunk, len(hunks))
+		for i, h := range hunks {
+			pbHunk := &pb.DiffHunk{
+				OldStart: int32(h.OldStart),
+				OldCount: int32(h.OldCount),
+				NewStart: int32(h.NewStart),
+				NewCount: int32(h.NewCount),
+				Lines:    make([]*pb.DiffLine, len(h.Lines)),
+			}
+			for j, line := range h.Lines {
+				pbHunk.Lines[j] = &pb.DiffLine{
+					Type:    line.Type,
+					Content: line.Content,
+					OldLine: int32(line.OldLine),
+					NewLine: int32(line.NewLine),
+				}
+			}
+			response.Hunks[i] = pbHunk
+		}
+	}
+
+	return response, nil
+}
+
+// GetArticleBlame returns per-line authorship for the current version of an article
+func (s *ArticleServiceImpl) GetArticleBlame(ctx context.Context, req *pb.GetArticleBlameRequest) (*pb.GetArticleBlameResponse, error) {
+	result, err := s.getArticleService().GetArticleBlame(uint(req.ArticleId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	response := &pb.GetArticleBlameResponse{
+		ArticleId:     uint32(getUint(result, "article_id")),
+		VersionId:     uint32(getUint(result, "version_id")),
+		VersionNumber: int32(getInt(result, "version_number")),
+	}
+	if lines, ok := result["lines"].([]article.BlameLine); ok {
+		response.Lines = make([]*pb.BlameLine, len(lines))
+		for i, line := range lines {
+			response.Lines[i] = &pb.BlameLine{
+				LineNumber:    int32(line.LineNumber),
+				Content:       line.Content,
+				VersionId:     uint32(line.VersionID),
+				VersionNumber: int32(line.VersionNumber),
+				AuthorId:      uint32(line.AuthorID),
+				CreatedAt:     line.CreatedAt.Format("2006-01-02 15:04:05"),
+			}
+		}
+	}
+
+	return response, nil
+}
+
 // CreateArticle creates a new article
 func (s *ArticleServiceImpl) CreateArticle(ctx context.Context, req *pb.CreateArticleRequest) (*pb.CreateArticleResponse, error) {
 	isReviewRequired := req.IsReviewRequired
@@ -271,17 +470,11 @@ func (s *ArticleServiceImpl) CreateSubmission(ctx context.Context, req *pb.Creat
 	if err != nil {
 		// Check for merge conflict error
 		if conflictErr, ok := err.(*article.MergeConflictError); ok {
-			cd := conflictErr.ConflictData
 			return &pb.CreateSubmissionResponse{
-				Published:  false,
-				NeedReview: false,
-				Message:    "合并冲突",
-				ConflictData: &pb.ConflictData{
-					HasConflict:          getBool(cd, "has_conflict"),
-					BaseVersionNumber:    int32(getInt(cd, "base_version_number")),
-					CurrentVersionNumber: int32(getInt(cd, "current_version_number")),
-					SubmitterName:        getString(cd, "submitter_name"),
-				},
+				Published:    false,
+				NeedReview:   false,
+				Message:      "合并冲突",
+				ConflictData: convertConflictData(conflictErr.ConflictData),
 			}, nil
 		}
 		return nil, status.Error(codes.Internal, err.Error())
@@ -306,6 +499,230 @@ func (s *ArticleServiceImpl) CreateSubmission(ctx context.Context, req *pb.Creat
 	return response, nil
 }
 
+// UpdateSubmission lets the submitter rebase a pending or conflicting submission as a new revision
+func (s *ArticleServiceImpl) UpdateSubmission(ctx context.Context, req *pb.UpdateSubmissionRequest) (*pb.UpdateSubmissionResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	submission, err := s.getArticleService().UpdateSubmission(uint(req.SubmissionId), dto.UpdateSubmissionRequest{
+		Content:       req.Content,
+		CommitMessage: req.CommitMessage,
+		BaseVersionID: uint(req.BaseVersionId),
+	}, uint(user.UserID))
+	if err != nil {
+		if err.Error() == "提交不存在" || err.Error() == "文章不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err.Error() == "permission denied: only the submitter can update the submission" {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.UpdateSubmissionResponse{
+		Message:    "提交已更新，等待审核",
+		Submission: convertSubmissionFromDTO(submission),
+	}, nil
+}
+
+// ReviseSubmission pushes a revised version onto a submission with requested changes
+func (s *ArticleServiceImpl) ReviseSubmission(ctx context.Context, req *pb.ReviseSubmissionRequest) (*pb.ReviseSubmissionResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	submission, revisedVersion, err := s.getArticleService().ReviseSubmission(uint(req.SubmissionId), dto.ReviseSubmissionRequest{
+		Content:       req.Content,
+		CommitMessage: req.CommitMessage,
+		BaseVersionID: uint(req.BaseVersionId),
+	}, uint(user.UserID))
+	if err != nil {
+		if err.Error() == "提交不存在" || err.Error() == "文章不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err.Error() == "permission denied: only the submitter can revise the submission" {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.ReviseSubmissionResponse{
+		Message:        "修订已提交，等待审核",
+		Submission:     convertSubmissionFromDTO(submission),
+		RevisedVersion: convertVersionFromDTO(revisedVersion),
+	}, nil
+}
+
+// WithdrawSubmission withdraws a pending or conflicting submission
+func (s *ArticleServiceImpl) WithdrawSubmission(ctx context.Context, req *pb.WithdrawSubmissionRequest) (*pb.WithdrawSubmissionResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	submission, err := s.getArticleService().WithdrawSubmission(uint(req.SubmissionId), uint(user.UserID))
+	if err != nil {
+		if err.Error() == "提交不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err.Error() == "permission denied: only the submitter or article admin can withdraw the submission" {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.WithdrawSubmissionResponse{
+		Message:    "提交已撤回",
+		Submission: convertSubmissionFromDTO(submission),
+	}, nil
+}
+
+// RevertToVersion reverts an article to a previous published version
+func (s *ArticleServiceImpl) RevertToVersion(ctx context.Context, req *pb.RevertToVersionRequest) (*pb.RevertToVersionResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	submission, publishedVersion, err := s.getArticleService().RevertToVersion(
+		uint(req.ArticleId), int(req.VersionNumber), req.Reason, uint(user.UserID), user.Role,
+	)
+
+	if err != nil {
+		// 并发修改导致的合并冲突
+		if conflictErr, ok := err.(*article.MergeConflictError); ok {
+			return &pb.RevertToVersionResponse{
+				Published:    false,
+				NeedReview:   false,
+				Message:      "合并冲突",
+				ConflictData: convertConflictData(conflictErr.ConflictData),
+			}, nil
+		}
+		if err.Error() == "文章不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	response := &pb.RevertToVersionResponse{}
+
+	if submission == nil {
+		response.Published = true
+		response.NeedReview = false
+		response.Message = "已回滚并发布"
+		if publishedVersion != nil {
+			response.PublishedVersion = convertVersionFromDTO(publishedVersion)
+		}
+	} else {
+		response.Published = false
+		response.NeedReview = true
+		response.Message = "回滚提交成功，等待审核"
+		response.Submission = convertSubmissionFromDTO(submission)
+	}
+
+	return response, nil
+}
+
+// SaveDraft saves the current user's personal draft of an article
+func (s *ArticleServiceImpl) SaveDraft(ctx context.Context, req *pb.SaveDraftRequest) (*pb.SaveDraftResponse, error) {
+	user := GetUserFromContext(ctx)
+
+	draft, err := s.getArticleService().SaveDraft(uint(req.ArticleId), uint(user.UserID), dto.SaveDraftRequest{
+		Content:       req.Content,
+		CommitMessage: req.CommitMessage,
+		BaseVersionID: uint(req.BaseVersionId),
+	})
+	if err != nil {
+		if err.Error() == "文章不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.SaveDraftResponse{Draft: convertDraft(draft)}, nil
+}
+
+// GetDraft gets the current user's personal draft of an article
+func (s *ArticleServiceImpl) GetDraft(ctx context.Context, req *pb.GetDraftRequest) (*pb.GetDraftResponse, error) {
+	user := GetUserFromContext(ctx)
+
+	draft, err := s.getArticleService().GetDraft(uint(req.ArticleId), uint(user.UserID))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &pb.GetDraftResponse{Draft: convertDraft(draft)}, nil
+}
+
+// DiscardDraft deletes the current user's personal draft of an article
+func (s *ArticleServiceImpl) DiscardDraft(ctx context.Context, req *pb.DiscardDraftRequest) (*pb.DiscardDraftResponse, error) {
+	user := GetUserFromContext(ctx)
+
+	if err := s.getArticleService().DiscardDraft(uint(req.ArticleId), uint(user.UserID)); err != nil {
+		if err.Error() == "草稿不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DiscardDraftResponse{Message: "草稿已丢弃"}, nil
+}
+
+// ListMyDrafts lists all personal drafts of the current user
+func (s *ArticleServiceImpl) ListMyDrafts(ctx context.Context, req *pb.ListMyDraftsRequest) (*pb.ListMyDraftsResponse, error) {
+	user := GetUserFromContext(ctx)
+
+	drafts, err := s.getArticleService().ListMyDrafts(uint(user.UserID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbDrafts := make([]*pb.ArticleDraft, len(drafts))
+	for i, d := range drafts {
+		pbDrafts[i] = convertDraft(d)
+	}
+
+	return &pb.ListMyDraftsResponse{Drafts: pbDrafts}, nil
+}
+
+// SubmitDraft submits the current user's draft as a normal submission
+func (s *ArticleServiceImpl) SubmitDraft(ctx context.Context, req *pb.SubmitDraftRequest) (*pb.SubmitDraftResponse, error) {
+	user := GetUserFromContext(ctx)
+
+	submission, publishedVersion, err := s.getArticleService().SubmitDraft(
+		uint(req.ArticleId), req.CommitMessage, uint(user.UserID), user.Role,
+	)
+
+	if err != nil {
+		// 合并冲突时草稿保留，客户端可基于最新版本继续编辑
+		if conflictErr, ok := err.(*article.MergeConflictError); ok {
+			return &pb.SubmitDraftResponse{
+				Published:    false,
+				NeedReview:   false,
+				Message:      "合并冲突",
+				ConflictData: convertConflictData(conflictErr.ConflictData),
+			}, nil
+		}
+		if err.Error() == "草稿不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.SubmitDraftResponse{}
+
+	if submission == nil {
+		response.Published = true
+		response.NeedReview = false
+		response.Message = "修改已发布"
+		if publishedVersion != nil {
+			response.PublishedVersion = convertVersionFromDTO(publishedVersion)
+		}
+	} else {
+		response.Published = false
+		response.NeedReview = true
+		response.Message = "提交成功，等待审核"
+		response.Submission = convertSubmissionFromDTO(submission)
+	}
+
+	return response, nil
+}
+
 // UpdateBasicInfo updates article basic information
 func (s *ArticleServiceImpl) UpdateBasicInfo(ctx context.Context, req *pb.UpdateBasicInfoRequest) (*pb.UpdateBasicInfoResponse, error) {
 	// 从 JWT 获取用户信息
@@ -325,6 +742,10 @@ func (s *ArticleServiceImpl) UpdateBasicInfo(ctx context.Context, req *pb.Update
 		isReviewRequired := req.IsReviewRequired
 		updateReq.IsReviewRequired = &isReviewRequired
 	}
+	if req.HasRequiredApprovals {
+		requiredApprovals := int(req.RequiredApprovals)
+		updateReq.RequiredApprovals = &requiredApprovals
+	}
 
 	err := s.getArticleService().UpdateBasicInfo(uint(req.ArticleId), uint(user.UserID), user.Role, updateReq)
 	if err != nil {
@@ -388,7 +809,48 @@ func (s *ArticleServiceImpl) RemoveCollaborator(ctx context.Context, req *pb.Rem
 	return &pb.RemoveCollaboratorResponse{}, nil
 }
 
-// DeleteArticle deletes an article and all related data
+// MoveArticle moves an article to another module
+func (s *ArticleServiceImpl) MoveArticle(ctx context.Context, req *pb.MoveArticleRequest) (*pb.MoveArticleResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	move, err := s.getArticleService().MoveArticle(uint(req.ArticleId), uint(req.TargetModuleId), uint(user.UserID), user.Role)
+	if err != nil {
+		switch err.Error() {
+		case "文章不存在", "目标模块不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		case "permission denied: moderator permission on the source module is required",
+			"permission denied: moderator permission on the target module is required":
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case "文章已在目标模块中":
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MoveArticleResponse{
+		ArticleId:    uint32(move.ArticleID),
+		FromModuleId: uint32(move.FromModuleID),
+		ToModuleId:   uint32(move.ToModuleID),
+		MovedAt:      move.CreatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// ResolveArticlePath resolves an article under a (possibly outdated) module path
+func (s *ArticleServiceImpl) ResolveArticlePath(ctx context.Context, req *pb.ResolveArticlePathRequest) (*pb.ResolveArticlePathResponse, error) {
+	moduleID, moved, err := s.getArticleService().ResolveArticleModule(uint(req.ModuleId), uint(req.ArticleId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &pb.ResolveArticlePathResponse{
+		ArticleId: req.ArticleId,
+		ModuleId:  uint32(moduleID),
+		Moved:     moved,
+	}, nil
+}
+
+// DeleteArticle moves an article to the trash, keeping all related data
 func (s *ArticleServiceImpl) DeleteArticle(ctx context.Context, req *pb.DeleteArticleRequest) (*pb.DeleteArticleResponse, error) {
 	// 从 JWT 获取用户信息
 	user := GetUserFromContext(ctx)
@@ -410,6 +872,410 @@ func (s *ArticleServiceImpl) DeleteArticle(ctx context.Context, req *pb.DeleteAr
 	}, nil
 }
 
+// ListTrash returns trashed articles in a module subtree
+func (s *ArticleServiceImpl) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	result, err := s.getArticleService().ListTrash(uint(req.ModuleId), uint(user.UserID), user.Role, page, pageSize)
+	if err != nil {
+		if err.Error() == "模块不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err.Error() == "permission denied: only module admins can manage the trash" {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items, _ := result["articles"].([]map[string]interface{})
+	articles := make([]*pb.TrashedArticle, len(items))
+	for i, a := range items {
+		articles[i] = &pb.TrashedArticle{
+			Id:        uint32(getUint(a, "id")),
+			Title:     getString(a, "title"),
+			ModuleId:  uint32(getUint(a, "module_id")),
+			CreatedBy: uint32(getUint(a, "created_by")),
+			DeletedBy: uint32(getUint(a, "deleted_by")),
+			DeletedAt: getString(a, "deleted_at"),
+			PurgeAt:   getString(a, "purge_at"),
+		}
+	}
+
+	return &pb.ListTrashResponse{
+		Total:    getInt64(result, "total"),
+		Page:     int32(getInt(result, "page")),
+		PageSize: int32(getInt(result, "page_size")),
+		Articles: articles,
+	}, nil
+}
+
+// RestoreArticle restores a trashed article together with its related data
+func (s *ArticleServiceImpl) RestoreArticle(ctx context.Context, req *pb.RestoreArticleRequest) (*pb.RestoreArticleResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	if err := s.getArticleService().RestoreArticle(uint(req.ArticleId), uint(user.UserID), user.Role); err != nil {
+		return nil, trashError(err)
+	}
+
+	return &pb.RestoreArticleResponse{
+		Success: true,
+		Message: "文章已恢复",
+	}, nil
+}
+
+// PurgeArticle permanently deletes a trashed article and all related data
+func (s *ArticleServiceImpl) PurgeArticle(ctx context.Context, req *pb.PurgeArticleRequest) (*pb.PurgeArticleResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	if err := s.getArticleService().PurgeArticle(uint(req.ArticleId), uint(user.UserID), user.Role); err != nil {
+		return nil, trashError(err)
+	}
+
+	return &pb.PurgeArticleResponse{
+		Success: true,
+		Message: "文章已永久删除",
+	}, nil
+}
+
+// trashError maps trash service errors to gRPC status codes
+func trashError(err error) error {
+	if err.Error() == "回收站中不存在该文章" {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if err.Error() == "permission denied: only module admins can manage the trash" {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// AddReference adds a typed reference from one article to another
+func (s *ArticleServiceImpl) AddReference(ctx context.Context, req *pb.AddReferenceRequest) (*pb.AddReferenceResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	ref, err := s.getArticleService().AddReference(uint(req.FromArticleId), uint(req.ToArticleId), req.ReferenceType, uint(user.UserID))
+	if err != nil {
+		switch err.Error() {
+		case "文章不存在", "被引用文章不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		case "permission denied: only article moderators can manage references":
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case "无效的引用类型", "不能引用文章自身":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case "引用关系已存在", "前置关系会形成循环":
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AddReferenceResponse{
+		FromArticleId: uint32(ref.FromArticleID),
+		ToArticleId:   uint32(ref.ToArticleID),
+		ReferenceType: ref.ReferenceType,
+		CreatedAt:     ref.CreatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// RemoveReference removes a reference between two articles
+func (s *ArticleServiceImpl) RemoveReference(ctx context.Context, req *pb.RemoveReferenceRequest) (*pb.RemoveReferenceResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	err := s.getArticleService().RemoveReference(uint(req.FromArticleId), uint(req.ToArticleId), uint(user.UserID))
+	if err != nil {
+		switch err.Error() {
+		case "文章不存在", "引用关系不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		case "permission denied: only article moderators can manage references":
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RemoveReferenceResponse{
+		Success: true,
+		Message: "引用已删除",
+	}, nil
+}
+
+// GetArticleReferences returns outgoing references and backlinks of an article
+func (s *ArticleServiceImpl) GetArticleReferences(ctx context.Context, req *pb.GetArticleReferencesRequest) (*pb.GetArticleReferencesResponse, error) {
+	result, err := s.getArticleService().GetArticleReferences(uint(req.ArticleId), req.ReferenceType)
+	if err != nil {
+		switch err.Error() {
+		case "文章不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		case "无效的引用类型":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	references, _ := result["references"].([]map[string]interface{})
+	backlinks, _ := result["backlinks"].([]map[string]interface{})
+
+	return &pb.GetArticleReferencesResponse{
+		ArticleId:  req.ArticleId,
+		References: convertReferenceItems(references),
+		Backlinks:  convertReferenceItems(backlinks),
+	}, nil
+}
+
+// GetLearningPath returns the transitive prerequisites of an article in reading order
+func (s *ArticleServiceImpl) GetLearningPath(ctx context.Context, req *pb.GetLearningPathRequest) (*pb.GetLearningPathResponse, error) {
+	result, err := s.getArticleService().GetLearningPath(uint(req.ArticleId))
+	if err != nil {
+		if err.Error() == "文章不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items, _ := result["path"].([]map[string]interface{})
+	path := make([]*pb.LearningPathItem, len(items))
+	for i, item := range items {
+		path[i] = &pb.LearningPathItem{
+			ArticleId: uint32(getUint(item, "article_id")),
+			Title:     getString(item, "title"),
+			ModuleId:  uint32(getUint(item, "module_id")),
+			Level:     int32(getInt(item, "level")),
+		}
+	}
+
+	cycleIDs, _ := result["cycles"].([][]uint)
+	cycles := make([]*pb.ArticleCycle, len(cycleIDs))
+	for i, ids := range cycleIDs {
+		articleIDs := make([]uint32, len(ids))
+		for j, id := range ids {
+			articleIDs[j] = uint32(id)
+		}
+		cycles[i] = &pb.ArticleCycle{ArticleIds: articleIDs}
+	}
+
+	return &pb.GetLearningPathResponse{
+		ArticleId: req.ArticleId,
+		Path:      path,
+		HasCycle:  getBool(result, "has_cycle"),
+		Cycles:    cycles,
+	}, nil
+}
+
+// GetBrokenLinkReport lists broken internal links and file references in a module subtree
+func (s *ArticleServiceImpl) GetBrokenLinkReport(ctx context.Context, req *pb.GetBrokenLinkReportRequest) (*pb.GetBrokenLinkReportResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	result, err := s.getArticleService().GetBrokenLinkReport(uint(req.ModuleId), uint(user.UserID), user.Role)
+	if err != nil {
+		if err.Error() == "模块不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err.Error() == "permission denied: only module admins can view the broken link report" {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items, _ := result["articles"].([]map[string]interface{})
+	articles := make([]*pb.BrokenLinkArticle, len(items))
+	for i, a := range items {
+		links, _ := a["broken_links"].([]map[string]interface{})
+		files, _ := a["broken_files"].([]map[string]interface{})
+		articles[i] = &pb.BrokenLinkArticle{
+			ArticleId:   uint32(getUint(a, "article_id")),
+			Title:       getString(a, "title"),
+			ModuleId:    uint32(getUint(a, "module_id")),
+			VersionId:   uint32(getUint(a, "version_id")),
+			BrokenLinks: convertBrokenLinks(links),
+			BrokenFiles: convertBrokenLinks(files),
+		}
+	}
+
+	return &pb.GetBrokenLinkReportResponse{
+		ModuleId:        req.ModuleId,
+		ArticlesChecked: int32(getInt(result, "articles_checked")),
+		BrokenLinkCount: int32(getInt(result, "broken_link_count")),
+		BrokenFileCount: int32(getInt(result, "broken_file_count")),
+		Articles:        articles,
+	}, nil
+}
+
+// GetArticleStats returns daily view and engagement statistics of an article
+func (s *ArticleServiceImpl) GetArticleStats(ctx context.Context, req *pb.GetArticleStatsRequest) (*pb.GetArticleStatsResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	result, err := s.getArticleService().GetArticleStats(uint(req.ArticleId), uint(user.UserID), user.Role, req.StartDate, req.EndDate)
+	if err != nil {
+		switch err.Error() {
+		case "文章不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		case "permission denied: only article collaborators can view stats":
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case "无效的日期范围":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	days, _ := result["series"].([]map[string]interface{})
+	series := make([]*pb.ArticleDailyStats, len(days))
+	for i, day := range days {
+		series[i] = convertDailyStats(day)
+	}
+	totals, _ := result["totals"].(map[string]interface{})
+
+	items, _ := result["revisions"].([]map[string]interface{})
+	revisions := make([]*pb.ArticleStatsRevision, len(items))
+	for i, item := range items {
+		revisions[i] = &pb.ArticleStatsRevision{
+			VersionId:     uint32(getUint(item, "version_id")),
+			VersionNumber: int32(getInt(item, "version_number")),
+			AuthorId:      uint32(getUint(item, "author_id")),
+			CommitMessage: getString(item, "commit_message"),
+			CreatedAt:     getString(item, "created_at"),
+		}
+	}
+
+	return &pb.GetArticleStatsResponse{
+		ArticleId: req.ArticleId,
+		StartDate: getString(result, "start_date"),
+		EndDate:   getString(result, "end_date"),
+		Series:    series,
+		Totals:    convertDailyStats(totals),
+		Revisions: revisions,
+	}, nil
+}
+
+// convertDailyStats converts a daily stats map to protobuf message
+func convertDailyStats(m map[string]interface{}) *pb.ArticleDailyStats {
+	return &pb.ArticleDailyStats{
+		Date:           getString(m, "date"),
+		Views:          getInt64(m, "views"),
+		UniqueVisitors: getInt64(m, "unique_visitors"),
+		Edits:          getInt64(m, "edits"),
+		Submissions:    getInt64(m, "submissions"),
+		Comments:       getInt64(m, "comments"),
+		VisitorDays:    getInt64(m, "visitor_days"),
+	}
+}
+
+// GetActivityFeed returns recent wiki-wide activity visible to the caller
+func (s *ArticleServiceImpl) GetActivityFeed(ctx context.Context, req *pb.GetActivityFeedRequest) (*pb.GetActivityFeedResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 50
+	}
+
+	result, err := s.getArticleService().GetActivityFeed(uint(user.UserID), uint(req.ModuleId), uint(req.UserId), req.EventTypes, page, pageSize)
+	if err != nil {
+		switch err.Error() {
+		case "无效的事件类型":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case "模块不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items, _ := result["events"].([]map[string]interface{})
+	events := make([]*pb.ActivityEvent, len(items))
+	for i, item := range items {
+		events[i] = &pb.ActivityEvent{
+			EventType:    getString(item, "event_type"),
+			OccurredAt:   getString(item, "occurred_at"),
+			ActorId:      uint32(getUint(item, "actor_id")),
+			ActorName:    getString(item, "actor_name"),
+			ArticleId:    uint32(getUint(item, "article_id")),
+			ArticleTitle: getString(item, "article_title"),
+			ModuleId:     uint32(getUint(item, "module_id")),
+			ModuleName:   getString(item, "module_name"),
+			RefId:        uint32(getUint(item, "ref_id")),
+			Detail:       getString(item, "detail"),
+		}
+	}
+
+	return &pb.GetActivityFeedResponse{
+		Total:    getInt64(result, "total"),
+		Page:     int32(getInt(result, "page")),
+		PageSize: int32(getInt(result, "page_size")),
+		Events:   events,
+	}, nil
+}
+
+// GetFeed returns an Atom or RSS document for a module subtree or an article's revisions
+func (s *ArticleServiceImpl) GetFeed(ctx context.Context, req *pb.GetFeedRequest) (*pb.GetFeedResponse, error) {
+	limit := int(req.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 50
+	}
+
+	result, err := s.getArticleService().GetFeed(req.FeedType, uint(req.Id), req.Format, limit)
+	if err != nil {
+		switch err.Error() {
+		case "无效的订阅类型", "无效的订阅格式":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case "模块不存在", "文章不存在":
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetFeedResponse{
+		ContentType: getString(result, "content_type"),
+		Content:     getString(result, "content"),
+	}, nil
+}
+
+// convertBrokenLinks converts broken link maps to protobuf messages
+func convertBrokenLinks(items []map[string]interface{}) []*pb.BrokenLink {
+	result := make([]*pb.BrokenLink, len(items))
+	for i, item := range items {
+		result[i] = &pb.BrokenLink{
+			Line:     int32(getInt(item, "line")),
+			LinkType: getString(item, "link_type"),
+			Target:   getString(item, "target"),
+			Reason:   getString(item, "reason"),
+		}
+	}
+	return result
+}
+
+// convertReferenceItems converts reference maps to protobuf items
+func convertReferenceItems(items []map[string]interface{}) []*pb.ArticleReferenceItem {
+	result := make([]*pb.ArticleReferenceItem, len(items))
+	for i, item := range items {
+		result[i] = &pb.ArticleReferenceItem{
+			ArticleId:     uint32(getUint(item, "article_id")),
+			Title:         getString(item, "title"),
+			ModuleId:      uint32(getUint(item, "module_id")),
+			ReferenceType: getString(item, "reference_type"),
+			CreatedAt:     getString(item, "created_at"),
+			IsAuto:        getBool(item, "is_auto"),
+		}
+	}
+	return result
+}
+
 // Helper to get the underlying article service
 func (s *ArticleServiceImpl) getArticleService() *article.ArticleService {
 	// Access the private field through reflection or expose it
@@ -484,6 +1350,10 @@ func getString(m map[string]interface{}, key string) string {
 			return val
 		case time.Time:
 			return val.Format("2006-01-02 15:04:05")
+		case *time.Time:
+			if val != nil {
+				return val.Format("2006-01-02 15:04:05")
+			}
 		}
 	}
 	return ""
@@ -586,6 +1456,23 @@ func getUintPtr(m map[string]interface{}, key string) uint {
 	return 0
 }
 
+// convertDraft converts draft info map to proto ArticleDraft
+func convertDraft(d map[string]interface{}) *pb.ArticleDraft {
+	return &pb.ArticleDraft{
+		Id:                uint32(getUint(d, "id")),
+		ArticleId:         uint32(getUint(d, "article_id")),
+		ArticleTitle:      getString(d, "article_title"),
+		UserId:            uint32(getUint(d, "user_id")),
+		BaseVersionId:     uint32(getUint(d, "base_version_id")),
+		BaseVersionNumber: int32(getInt(d, "base_version_number")),
+		Content:           getString(d, "content"),
+		CommitMessage:     getString(d, "commit_message"),
+		IsOutdated:        getBool(d, "is_outdated"),
+		CreatedAt:         getString(d, "created_at"),
+		UpdatedAt:         getString(d, "updated_at"),
+	}
+}
+
 // convertArticleVersion converts ArticleVersion model to proto Version
 func convertArticleVersion(v *articleModel.ArticleVersion) *pb.Version {
 	if v == nil {
@@ -603,6 +1490,34 @@ func convertArticleVersion(v *articleModel.ArticleVersion) *pb.Version {
 	}
 }
 
+// convertConflictData converts conflict data map to proto ConflictData
+func convertConflictData(cd map[string]interface{}) *pb.ConflictData {
+	pbData := &pb.ConflictData{
+		HasConflict:          getBool(cd, "has_conflict"),
+		BaseVersionNumber:    int32(getInt(cd, "base_version_number")),
+		CurrentVersionNumber: int32(getInt(cd, "current_version_number")),
+		SubmitterName:        getString(cd, "submitter_name"),
+		SubmitterId:          uint32(getUint(cd, "submitter_id")),
+	}
+	if hunks, ok := cd["conflict_hunks"].([]article.ConflictHunk); ok {
+		pbData.Hunks = make([]*pb.ConflictHunk, len(hunks))
+		for i, h := range hunks {
+			pbData.Hunks[i] = &pb.ConflictHunk{
+				BaseStart:    int32(h.BaseStart),
+				BaseCount:    int32(h.BaseCount),
+				TheirStart:   int32(h.TheirStart),
+				TheirCount:   int32(h.TheirCount),
+				OurStart:     int32(h.OurStart),
+				OurCount:     int32(h.OurCount),
+				BaseContent:  h.BaseContent,
+				TheirContent: h.TheirContent,
+				OurContent:   h.OurContent,
+			}
+		}
+	}
+	return pbData
+}
+
 // convertReviewSubmission converts ReviewSubmission model to proto Submission
 func convertReviewSubmission(s *articleModel.ReviewSubmission) *pb.Submission {
 	if s == nil {
@@ -39,6 +39,39 @@ func ExtractToken(ctx context.Context) string {
 	return token
 }
 
+// ClientFingerprint identifies an anonymous reader for view de-duplication.
+// The gateway is expected to pass "x-client-fingerprint"; otherwise the forwarded
+// client IP and user agent are used. Returns "" when nothing is available.
+func ClientFingerprint(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get("x-client-fingerprint"); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+
+	ip := ""
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		ip = strings.TrimSpace(strings.Split(values[0], ",")[0])
+	}
+	if ip == "" {
+		if values := md.Get("x-real-ip"); len(values) > 0 {
+			ip = values[0]
+		}
+	}
+	if ip == "" {
+		return ""
+	}
+
+	userAgent := ""
+	if values := md.Get("user-agent"); len(values) > 0 {
+		userAgent = values[0]
+	}
+	return ip + "|" + userAgent
+}
+
 // ExtractUserInfo extracts user_id and user_role from gRPC metadata
 // These are passed from Node.js Gateway after JWT validation
 // Deprecated: 使用 GetUserFromContext 代替，它直接从 JWT 解析用户信息
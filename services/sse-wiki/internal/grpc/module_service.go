@@ -65,15 +65,21 @@ func (s *ModuleServiceImpl) GetModule(ctx context.Context, req *pb.GetModuleRequ
 		description = *mod.Description
 	}
 
+	requiredApprovals := int32(0)
+	if mod.RequiredApprovals != nil {
+		requiredApprovals = int32(*mod.RequiredApprovals)
+	}
+
 	return &pb.GetModuleResponse{
 		Module: &pb.Module{
-			Id:          uint32(mod.ID),
-			Name:        mod.ModuleName,
-			Description: description,
-			ParentId:    parentID,
-			OwnerId:     uint32(mod.OwnerID),
-			CreatedAt:   mod.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:   mod.UpdatedAt.Format("2006-01-02 15:04:05"),
+			Id:                uint32(mod.ID),
+			Name:              mod.ModuleName,
+			Description:       description,
+			ParentId:          parentID,
+			OwnerId:           uint32(mod.OwnerID),
+			CreatedAt:         mod.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:         mod.UpdatedAt.Format("2006-01-02 15:04:05"),
+			RequiredApprovals: requiredApprovals,
 		},
 	}, nil
 }
@@ -147,6 +153,10 @@ func (s *ModuleServiceImpl) UpdateModule(ctx context.Context, req *pb.UpdateModu
 		parentID := uint(req.ParentId)
 		updateReq.ParentID = &parentID
 	}
+	if req.RequiredApprovals != nil {
+		requiredApprovals := int(*req.RequiredApprovals)
+		updateReq.RequiredApprovals = &requiredApprovals
+	}
 
 	err := s.moduleService.UpdateModule(uint(req.Id), updateReq, uint(user.UserID), user.Role)
 	if err != nil {
@@ -106,11 +106,55 @@ func (s *ReviewServiceImpl) GetReviewDetail(ctx context.Context, req *pb.GetRevi
 
 	// Convert conflict_data
 	if conflictData, ok := detail["conflict_data"].(map[string]interface{}); ok && conflictData != nil {
-		pbDetail.ConflictData = &pb.ConflictData{
-			HasConflict:          getBool(conflictData, "has_conflict"),
-			BaseVersionNumber:    int32(getInt(conflictData, "base_version_number")),
-			CurrentVersionNumber: int32(getInt(conflictData, "current_version_number")),
-			SubmitterName:        getString(conflictData, "submitter_name"),
+		pbDetail.ConflictData = convertConflictDataToReviewPb(conflictData)
+	}
+
+	// Convert revisions
+	pbDetail.ReviewNotes = getString(detail, "review_notes")
+	pbDetail.RevisionNumber = int32(getInt(detail, "revision_number"))
+	if revisions, ok := detail["revisions"].([]map[string]interface{}); ok {
+		pbDetail.Revisions = make([]*pb.SubmissionRevision, len(revisions))
+		for i, rev := range revisions {
+			pbDetail.Revisions[i] = &pb.SubmissionRevision{
+				RevisionNumber: int32(getInt(rev, "revision_number")),
+				VersionId:      uint32(getUint(rev, "version_id")),
+				VersionNumber:  int32(getInt(rev, "version_number")),
+				BaseVersionId:  uint32(getUint(rev, "base_version_id")),
+				CommitMessage:  getString(rev, "commit_message"),
+				ReviewedBy:     uint32(getUint(rev, "reviewed_by")),
+				ReviewNotes:    getString(rev, "review_notes"),
+				ReviewedAt:     getString(rev, "reviewed_at"),
+				CreatedAt:      getString(rev, "created_at"),
+			}
+		}
+	}
+
+	// Convert votes
+	pbDetail.RequiredApprovals = int32(getInt(detail, "required_approvals"))
+	pbDetail.Approvals = int32(getInt(detail, "approvals"))
+	pbDetail.Rejections = int32(getInt(detail, "rejections"))
+	if votes, ok := detail["votes"].([]map[string]interface{}); ok {
+		pbDetail.Votes = make([]*pb.ReviewVote, len(votes))
+		for i, v := range votes {
+			pbDetail.Votes[i] = &pb.ReviewVote{
+				ReviewerId: uint32(getUint(v, "reviewer_id")),
+				Vote:       getString(v, "vote"),
+				Notes:      getString(v, "notes"),
+			}
+			if createdAt, ok := v["created_at"].(time.Time); ok {
+				pbDetail.Votes[i].CreatedAt = createdAt.Format(timeFormat)
+			}
+			if updatedAt, ok := v["updated_at"].(time.Time); ok {
+				pbDetail.Votes[i].UpdatedAt = updatedAt.Format(timeFormat)
+			}
+		}
+	}
+
+	// Convert inline comments
+	if comments, ok := detail["comments"].([]map[string]interface{}); ok {
+		pbDetail.Comments = make([]*pb.ReviewComment, len(comments))
+		for i, c := range comments {
+			pbDetail.Comments[i] = convertReviewCommentToPb(c)
 		}
 	}
 
@@ -151,7 +195,7 @@ func (s *ReviewServiceImpl) GetReviewDetail(ctx context.Context, req *pb.GetRevi
 	return &pb.GetReviewDetailResponse{Detail: pbDetail}, nil
 }
 
-// ReviewAction performs a review action (approve/reject)
+// ReviewAction performs a review action (approve/reject/request_changes)
 func (s *ReviewServiceImpl) ReviewAction(ctx context.Context, req *pb.ReviewActionRequest) (*pb.ReviewActionResponse, error) {
 	// 从 JWT 获取用户信息
 	user := GetUserFromContext(ctx)
@@ -171,15 +215,9 @@ func (s *ReviewServiceImpl) ReviewAction(ctx context.Context, req *pb.ReviewActi
 	if err != nil {
 		// Check for merge conflict error
 		if conflictErr, ok := err.(*article.MergeConflictError); ok {
-			cd := conflictErr.ConflictData
 			return &pb.ReviewActionResponse{
-				Message: "检测到冲突",
-				ConflictData: &pb.ConflictData{
-					HasConflict:          getBool(cd, "has_conflict"),
-					BaseVersionNumber:    int32(getInt(cd, "base_version_number")),
-					CurrentVersionNumber: int32(getInt(cd, "current_version_number")),
-					SubmitterName:        getString(cd, "submitter_name"),
-				},
+				Message:      "检测到冲突",
+				ConflictData: convertConflictDataToReviewPb(conflictErr.ConflictData),
 			}, nil
 		}
 		return nil, status.Error(codes.Internal, err.Error())
@@ -188,6 +226,12 @@ func (s *ReviewServiceImpl) ReviewAction(ctx context.Context, req *pb.ReviewActi
 	response := &pb.ReviewActionResponse{
 		Message: getString(result.(map[string]interface{}), "message"),
 	}
+	// 投票尚未达到合并或驳回条件时返回统计
+	if resultMap, ok := result.(map[string]interface{}); ok {
+		response.RequiredApprovals = int32(getInt(resultMap, "required_approvals"))
+		response.Approvals = int32(getInt(resultMap, "approvals"))
+		response.Rejections = int32(getInt(resultMap, "rejections"))
+	}
 
 	// Convert published version if present
 	// 服务层返回的 published_version 是 *article.ArticleVersion 结构体
@@ -200,6 +244,82 @@ func (s *ReviewServiceImpl) ReviewAction(ctx context.Context, req *pb.ReviewActi
 	return response, nil
 }
 
+// AddReviewComment adds an inline comment (or a reply) to a submission
+func (s *ReviewServiceImpl) AddReviewComment(ctx context.Context, req *pb.AddReviewCommentRequest) (*pb.AddReviewCommentResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	comment, err := s.getArticleService().AddReviewComment(uint(req.SubmissionId), uint(user.UserID), dto.ReviewCommentRequest{
+		Content:   req.Content,
+		StartLine: int(req.StartLine),
+		EndLine:   int(req.EndLine),
+		ParentID:  uint(req.ParentId),
+	})
+	if err != nil {
+		if err.Error() == "提交不存在" || err.Error() == "回复的评论不存在" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err.Error() == "permission denied: only reviewers and the submitter can comment on submissions" {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	pbComment := &pb.ReviewComment{
+		Id:                uint32(comment.ID),
+		SubmissionId:      uint32(comment.SubmissionID),
+		VersionId:         uint32(comment.VersionID),
+		StartLine:         int32(comment.StartLine),
+		EndLine:           int32(comment.EndLine),
+		OriginalVersionId: uint32(comment.OriginalVersionID),
+		OriginalStartLine: int32(comment.OriginalStartLine),
+		OriginalEndLine:   int32(comment.OriginalEndLine),
+		IsOutdated:        comment.IsOutdated,
+		AuthorId:          uint32(comment.AuthorID),
+		Content:           comment.Content,
+		CreatedAt:         comment.CreatedAt.Format(timeFormat),
+		UpdatedAt:         comment.UpdatedAt.Format(timeFormat),
+	}
+	if comment.ParentID != nil {
+		pbComment.ParentId = uint32(*comment.ParentID)
+	}
+
+	return &pb.AddReviewCommentResponse{Comment: pbComment}, nil
+}
+
+// convertReviewCommentToPb converts an inline comment map (with optional replies) to proto ReviewComment
+func convertReviewCommentToPb(c map[string]interface{}) *pb.ReviewComment {
+	pbComment := &pb.ReviewComment{
+		Id:                uint32(getUint(c, "id")),
+		SubmissionId:      uint32(getUint(c, "submission_id")),
+		VersionId:         uint32(getUint(c, "version_id")),
+		StartLine:         int32(getInt(c, "start_line")),
+		EndLine:           int32(getInt(c, "end_line")),
+		OriginalVersionId: uint32(getUint(c, "original_version_id")),
+		OriginalStartLine: int32(getInt(c, "original_start_line")),
+		OriginalEndLine:   int32(getInt(c, "original_end_line")),
+		IsOutdated:        getBool(c, "is_outdated"),
+		AuthorId:          uint32(getUint(c, "author_id")),
+		Content:           getString(c, "content"),
+	}
+	if parentID, ok := c["parent_id"].(*uint); ok && parentID != nil {
+		pbComment.ParentId = uint32(*parentID)
+	}
+	if createdAt, ok := c["created_at"].(time.Time); ok {
+		pbComment.CreatedAt = createdAt.Format(timeFormat)
+	}
+	if updatedAt, ok := c["updated_at"].(time.Time); ok {
+		pbComment.UpdatedAt = updatedAt.Format(timeFormat)
+	}
+	if replies, ok := c["replies"].([]map[string]interface{}); ok {
+		pbComment.Replies = make([]*pb.ReviewComment, len(replies))
+		for i, r := range replies {
+			pbComment.Replies[i] = convertReviewCommentToPb(r)
+		}
+	}
+	return pbComment
+}
+
 // convertVersionModelToReviewPb converts ArticleVersion model to review_service proto Version
 func convertVersionModelToReviewPb(v *articleModel.ArticleVersion) *pb.Version {
 	if v == nil {
@@ -217,6 +337,34 @@ func convertVersionModelToReviewPb(v *articleModel.ArticleVersion) *pb.Version {
 	}
 }
 
+// convertConflictDataToReviewPb converts conflict data map to review_service proto ConflictData
+func convertConflictDataToReviewPb(cd map[string]interface{}) *pb.ConflictData {
+	pbData := &pb.ConflictData{
+		HasConflict:          getBool(cd, "has_conflict"),
+		BaseVersionNumber:    int32(getInt(cd, "base_version_number")),
+		CurrentVersionNumber: int32(getInt(cd, "current_version_number")),
+		SubmitterName:        getString(cd, "submitter_name"),
+		SubmitterId:          uint32(getUint(cd, "submitter_id")),
+	}
+	if hunks, ok := cd["conflict_hunks"].([]article.ConflictHunk); ok {
+		pbData.Hunks = make([]*pb.ConflictHunk, len(hunks))
+		for i, h := range hunks {
+			pbData.Hunks[i] = &pb.ConflictHunk{
+				BaseStart:    int32(h.BaseStart),
+				BaseCount:    int32(h.BaseCount),
+				TheirStart:   int32(h.TheirStart),
+				TheirCount:   int32(h.TheirCount),
+				OurStart:     int32(h.OurStart),
+				OurCount:     int32(h.OurCount),
+				BaseContent:  h.BaseContent,
+				TheirContent: h.TheirContent,
+				OurContent:   h.OurContent,
+			}
+		}
+	}
+	return pbData
+}
+
 // convertReviewSubmissionModel converts ReviewSubmission model to proto Submission
 func convertReviewSubmissionModel(s *articleModel.ReviewSubmission) *pb.Submission {
 	if s == nil {
@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terminal-terrace/sse-wiki/config"
+	"terminal-terrace/sse-wiki/internal/article"
+	"terminal-terrace/sse-wiki/internal/database"
+	articleModel "terminal-terrace/sse-wiki/internal/model/article"
+	"terminal-terrace/sse-wiki/internal/testutils"
+	pb "terminal-terrace/sse-wiki/protobuf/proto/review_service"
+)
+
+func TestGetReviewDetailConflictHunks_Integration(t *testing.T) {
+	db := testutils.SetupTestDB(t)
+	previousDB, previousConf := database.PostgresDB, config.Conf
+	database.PostgresDB = db
+	if config.Conf == nil {
+		config.Conf = &config.AppConfig{}
+	}
+	t.Cleanup(func() { database.PostgresDB, config.Conf = previousDB, previousConf })
+
+	author := testutils.CreateTestUser(db)
+	submitter := testutils.CreateTestUser(db)
+	testModule := testutils.CreateTestModule(db, author.ID)
+	testArticle := testutils.CreateTestArticle(db, testModule.ID, author.ID)
+
+	// 基础版本之后，已发布版本和提交版本修改了相隔较远的两处相同行
+	baseContent := "# Title\nfirst\n\nkeep 1\nkeep 2\nkeep 3\nkeep 4\n\nsecond\n"
+	ourContent := "# Title\nfirst (ours)\n\nkeep 1\nkeep 2\nkeep 3\nkeep 4\n\nsecond (ours)\n"
+	theirContent := "# Title\nfirst (theirs)\n\nkeep 1\nkeep 2\nkeep 3\nkeep 4\n\nsecond (theirs)\n"
+
+	createVersion := func(number int, content, status string, baseID *uint) *articleModel.ArticleVersion {
+		v := &articleModel.ArticleVersion{
+			ArticleID:     testArticle.ID,
+			VersionNumber: number,
+			Content:       content,
+			CommitMessage: "test",
+			AuthorID:      author.ID,
+			Status:        status,
+			BaseVersionID: baseID,
+			CreatedAt:     time.Now(),
+		}
+		if err := db.Create(v).Error; err != nil {
+			t.Fatalf("Failed to create version %d: %v", number, err)
+		}
+		return v
+	}
+	baseVersion := createVersion(1, baseContent, "published", nil)
+	currentVersion := createVersion(2, ourContent, "published", &baseVersion.ID)
+	proposedVersion := createVersion(3, theirContent, "pending", &baseVersion.ID)
+	testArticle.CurrentVersionID = &currentVersion.ID
+	if err := db.Save(testArticle).Error; err != nil {
+		t.Fatalf("Failed to update article: %v", err)
+	}
+
+	submission := &articleModel.ReviewSubmission{
+		ArticleID:         testArticle.ID,
+		ProposedVersionID: proposedVersion.ID,
+		BaseVersionID:     baseVersion.ID,
+		SubmittedBy:       submitter.ID,
+		Status:            "pending",
+		ProposedTags:      "[]",
+		CreatedAt:         time.Now(),
+	}
+	if err := db.Create(submission).Error; err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	resp, err := NewReviewServiceImpl().GetReviewDetail(context.Background(), &pb.GetReviewDetailRequest{
+		SubmissionId: uint32(submission.ID),
+	})
+	if err != nil {
+		t.Fatalf("GetReviewDetail failed: %v", err)
+	}
+
+	cd := resp.GetDetail().GetConflictData()
+	if !cd.GetHasConflict() {
+		t.Fatalf("Expected conflict data to be returned")
+	}
+	if cd.GetBaseVersionNumber() != 1 || cd.GetCurrentVersionNumber() != 2 {
+		t.Errorf("Expected base/current version numbers 1/2, got %d/%d",
+			cd.GetBaseVersionNumber(), cd.GetCurrentVersionNumber())
+	}
+	if cd.GetSubmitterId() != uint32(submitter.ID) {
+		t.Errorf("Expected submitter %d, got %d", submitter.ID, cd.GetSubmitterId())
+	}
+
+	expected := article.NewMergeService().ThreeWayMerge(baseContent, theirContent, ourContent).Conflicts
+	if len(expected) < 2 {
+		t.Fatalf("Expected the fixture to produce multiple hunks, got %d", len(expected))
+	}
+	if len(cd.GetHunks()) != len(expected) {
+		t.Fatalf("Expected %d hunks, got %d", len(expected), len(cd.GetHunks()))
+	}
+	for i, h := range expected {
+		got := cd.GetHunks()[i]
+		want := &pb.ConflictHunk{
+			BaseStart:    int32(h.BaseStart),
+			BaseCount:    int32(h.BaseCount),
+			TheirStart:   int32(h.TheirStart),
+			TheirCount:   int32(h.TheirCount),
+			OurStart:     int32(h.OurStart),
+			OurCount:     int32(h.OurCount),
+			BaseContent:  h.BaseContent,
+			TheirContent: h.TheirContent,
+			OurContent:   h.OurContent,
+		}
+		if got.String() != want.String() {
+			t.Errorf("Hunk %d mismatch:\nexpected %v\ngot      %v", i, want, got)
+		}
+	}
+}
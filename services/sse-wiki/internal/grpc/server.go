@@ -8,6 +8,7 @@ import (
 	discussionpb "terminal-terrace/sse-wiki/protobuf/proto/discussion_service"
 	modulepb "terminal-terrace/sse-wiki/protobuf/proto/module_service"
 	reviewpb "terminal-terrace/sse-wiki/protobuf/proto/review_service"
+	tagpb "terminal-terrace/sse-wiki/protobuf/proto/tag_service"
 
 	"google.golang.org/grpc"
 )
@@ -19,7 +20,7 @@ type Server struct {
 }
 
 // NewServer creates a new gRPC server with all wiki services registered
-func NewServer(port int, moduleService modulepb.ModuleServiceServer, articleService articlepb.ArticleServiceServer, reviewService reviewpb.ReviewServiceServer, discussionService discussionpb.DiscussionServiceServer) (*Server, error) {
+func NewServer(port int, moduleService modulepb.ModuleServiceServer, articleService articlepb.ArticleServiceServer, reviewService reviewpb.ReviewServiceServer, discussionService discussionpb.DiscussionServiceServer, tagService tagpb.TagServiceServer) (*Server, error) {
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen on port %d: %w", port, err)
@@ -32,6 +33,7 @@ func NewServer(port int, moduleService modulepb.ModuleServiceServer, articleServ
 	articlepb.RegisterArticleServiceServer(grpcServer, articleService)
 	reviewpb.RegisterReviewServiceServer(grpcServer, reviewService)
 	discussionpb.RegisterDiscussionServiceServer(grpcServer, discussionService)
+	tagpb.RegisterTagServiceServer(grpcServer, tagService)
 	
 
 	return &Server{
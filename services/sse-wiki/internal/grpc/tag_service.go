@@ -0,0 +1,166 @@
+package grpc
+
+import (
+	"context"
+
+	"terminal-terrace/sse-wiki/internal/article"
+	"terminal-terrace/sse-wiki/internal/database"
+	pb "terminal-terrace/sse-wiki/protobuf/proto/tag_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TagServiceImpl implements the TagService gRPC interface
+type TagServiceImpl struct {
+	pb.UnimplementedTagServiceServer
+}
+
+// NewTagServiceImpl creates a new TagService implementation
+func NewTagServiceImpl() *TagServiceImpl {
+	return &TagServiceImpl{}
+}
+
+// getArticleService creates an ArticleService instance
+func (s *TagServiceImpl) getArticleService() *article.ArticleService {
+	articleRepo := article.NewArticleRepository(database.PostgresDB)
+	versionRepo := article.NewVersionRepository(database.PostgresDB)
+	submissionRepo := article.NewSubmissionRepository(database.PostgresDB)
+	tagRepo := article.NewTagRepository(database.PostgresDB)
+	mergeService := article.NewMergeService()
+	return article.NewArticleService(articleRepo, versionRepo, submissionRepo, tagRepo, mergeService)
+}
+
+// ListTags returns tags with usage counts
+func (s *TagServiceImpl) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	result, err := s.getArticleService().ListTags(req.Keyword, req.SortBy, page, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items, _ := result["tags"].([]map[string]interface{})
+	return &pb.ListTagsResponse{
+		Total:    getInt64(result, "total"),
+		Page:     int32(getInt(result, "page")),
+		PageSize: int32(getInt(result, "page_size")),
+		Tags:     convertTags(items),
+	}, nil
+}
+
+// AutocompleteTags returns tags whose names start with the given prefix
+func (s *TagServiceImpl) AutocompleteTags(ctx context.Context, req *pb.AutocompleteTagsRequest) (*pb.AutocompleteTagsResponse, error) {
+	limit := int(req.Limit)
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	items, err := s.getArticleService().AutocompleteTags(req.Prefix, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AutocompleteTagsResponse{Tags: convertTags(items)}, nil
+}
+
+// RenameTag renames a tag (global admins only)
+func (s *TagServiceImpl) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*pb.RenameTagResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	tag, err := s.getArticleService().RenameTag(uint(req.TagId), req.Name, user.Role)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &pb.RenameTagResponse{Tag: convertTag(tag)}, nil
+}
+
+// MergeTags merges duplicate tags into a target tag (global admins only)
+func (s *TagServiceImpl) MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*pb.MergeTagsResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	sourceIDs := make([]uint, len(req.SourceTagIds))
+	for i, id := range req.SourceTagIds {
+		sourceIDs[i] = uint(id)
+	}
+
+	tag, err := s.getArticleService().MergeTags(sourceIDs, uint(req.TargetTagId), user.Role)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &pb.MergeTagsResponse{Tag: convertTag(tag)}, nil
+}
+
+// UpdateTagColor sets the display color of a tag (global admins only)
+func (s *TagServiceImpl) UpdateTagColor(ctx context.Context, req *pb.UpdateTagColorRequest) (*pb.UpdateTagColorResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	tag, err := s.getArticleService().UpdateTagColor(uint(req.TagId), req.Color, user.Role)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &pb.UpdateTagColorResponse{Tag: convertTag(tag)}, nil
+}
+
+// DeleteTag deletes an unused tag (global admins only)
+func (s *TagServiceImpl) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	// 从 JWT 获取用户信息
+	user := GetUserFromContext(ctx)
+
+	if err := s.getArticleService().DeleteTag(uint(req.TagId), user.Role); err != nil {
+		return nil, tagError(err)
+	}
+
+	return &pb.DeleteTagResponse{
+		Success: true,
+		Message: "标签已删除",
+	}, nil
+}
+
+// tagError maps tag service errors to gRPC status codes
+func tagError(err error) error {
+	switch err.Error() {
+	case "标签不存在":
+		return status.Error(codes.NotFound, err.Error())
+	case "permission denied: only global admins can manage tags":
+		return status.Error(codes.PermissionDenied, err.Error())
+	case "标签名不能为空且不能超过50个字符", "无效的颜色值", "请指定要合并的标签", "不能将标签合并到自身":
+		return status.Error(codes.InvalidArgument, err.Error())
+	case "标签名已存在，请使用合并", "标签仍被文章使用，无法删除":
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// convertTag converts a tag map to protobuf Tag
+func convertTag(t map[string]interface{}) *pb.Tag {
+	return &pb.Tag{
+		Id:         uint32(getUint(t, "id")),
+		Name:       getString(t, "name"),
+		Color:      getString(t, "color"),
+		UsageCount: getInt64(t, "usage_count"),
+		CreatedAt:  getString(t, "created_at"),
+	}
+}
+
+// convertTags converts tag maps to protobuf Tags
+func convertTags(items []map[string]interface{}) []*pb.Tag {
+	tags := make([]*pb.Tag, len(items))
+	for i, t := range items {
+		tags[i] = convertTag(t)
+	}
+	return tags
+}
@@ -20,10 +20,14 @@ type Article struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	// 当前文章是否需要审核（默认true，可由管理员设置）
 	IsReviewRequired *bool `gorm:"default:true" json:"is_review_required"`
+	// 审核通过所需的审核人数（为空表示继承所属模块的设置）
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
 	// 阅读量统计
 	ViewCount uint `gorm:"default:0" json:"view_count"`
-	// 软删除时间（为空表示未删除）
+	// 软删除时间（为空表示未删除，不为空表示在回收站中）
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+	// 删除人ID（移入回收站时记录）
+	DeletedBy *uint `json:"deleted_by,omitempty"`
 }
 
 // ArticleCollaborator 文章协作者表（权限独立设计）
@@ -42,19 +46,29 @@ type ArticleCollaborator struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
-// ArticleVersion 文章版本历史表 (全量存储)
+// ArticleVersion 文章版本历史表
+// 内容按快照+增量存储（见 StorageType），读取时由 VersionRepository 透明还原到 Content
 type ArticleVersion struct {
 	ID        uint `gorm:"primaryKey" json:"id"`
 	ArticleID uint `gorm:"not null;uniqueIndex:idx_article_version_unique" json:"article_id"`
 	// 版本号，在article_id下递增 (1, 2, 3...)
 	VersionNumber int `gorm:"not null;uniqueIndex:idx_article_version_unique" json:"version_number"`
-	// Markdown原文，全量存储
+	// Markdown原文（仅 full 存储方式在数据库中保存原文，其他方式读取时还原）
 	Content string `gorm:"type:text;not null" json:"content"`
+	// 存储方式: full(未压缩原文，历史数据), snapshot(压缩快照), delta(相对 DeltaBaseVersionID 的压缩增量)
+	StorageType string `gorm:"type:varchar(20);default:'full'" json:"-"`
+	// 压缩后的快照或增量数据
+	StoredContent []byte `gorm:"type:bytea" json:"-"`
+	// 增量所基于的版本ID（仅 delta 存储方式有值）
+	DeltaBaseVersionID *uint `json:"-"`
+	// 距最近快照的增量链长度（快照和 full 为 0）
+	DeltaDepth int `gorm:"default:0" json:"-"`
 	// 提交信息
 	CommitMessage string `gorm:"type:varchar(255)" json:"commit_message"`
 	// 版本作者ID
 	AuthorID uint `gorm:"not null;index" json:"author_id"`
-	// 版本状态: pending(待审核/未发布), published(已发布), rejected(已驳回)
+	// 版本状态: pending(待审核/未发布), published(已发布), rejected(已驳回), withdrawn(提交已撤回),
+	// superseded(被同一提交的新修订替代)
 	Status string `gorm:"type:varchar(50);default:'pending'" json:"status"`
 	// 基于哪个版本创建（用于diff对比，v1为null）
 	BaseVersionID *uint `gorm:"index" json:"base_version_id,omitempty"`
@@ -81,7 +95,8 @@ type ReviewSubmission struct {
 	SubmittedBy uint `gorm:"not null;index" json:"submitted_by"`
 	// 审核人ID
 	ReviewedBy *uint `gorm:"index" json:"reviewed_by,omitempty"`
-	// 状态: pending, approved, rejected, conflict_detected, merged
+	// 状态: pending, approved, rejected, conflict_detected, merged, withdrawn(提交者或文章管理员撤回),
+	// changes_requested(审核人要求修改，等待提交者推送新修订)
 	Status string `gorm:"type:varchar(50);default:'pending';index:idx_article_status" json:"status"`
 	// 审核备注（审核人填写）
 	ReviewNotes string `gorm:"type:text" json:"review_notes,omitempty"`
@@ -93,6 +108,63 @@ type ReviewSubmission struct {
 	MergedAgainstVersionID *uint      `gorm:"index" json:"merged_against_version_id,omitempty"`
 	CreatedAt              time.Time  `json:"created_at"`
 	ReviewedAt             *time.Time `json:"reviewed_at,omitempty"`
+	// 撤回人ID（提交者本人或文章管理员，撤回不属于审核决定）
+	WithdrawnBy *uint      `json:"withdrawn_by,omitempty"`
+	WithdrawnAt *time.Time `json:"withdrawn_at,omitempty"`
+}
+
+// SubmissionRevision 提交的历史修订（审核人要求修改后被新修订替代的版本）
+// 提交当前的修订始终是 ReviewSubmission.ProposedVersionID
+type SubmissionRevision struct {
+	ID           uint `gorm:"primaryKey" json:"id"`
+	SubmissionID uint `gorm:"not null;uniqueIndex:idx_submission_revision" json:"submission_id"`
+	// 修订序号，从1开始
+	RevisionNumber int `gorm:"not null;uniqueIndex:idx_submission_revision" json:"revision_number"`
+	// 该修订对应的版本ID（状态为 superseded）
+	VersionID     uint `gorm:"not null" json:"version_id"`
+	BaseVersionID uint `gorm:"not null" json:"base_version_id"`
+	// 要求修改的审核人及备注
+	ReviewedBy  *uint      `json:"reviewed_by,omitempty"`
+	ReviewNotes string     `gorm:"type:text" json:"review_notes,omitempty"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ReviewVote 审核投票表（多人审核）
+// 每位审核人对同一提交只保留一票，重复投票覆盖之前的投票；提交内容更新后投票清空
+type ReviewVote struct {
+	ID           uint `gorm:"primaryKey" json:"id"`
+	SubmissionID uint `gorm:"not null;uniqueIndex:idx_submission_reviewer" json:"submission_id"`
+	ReviewerID   uint `gorm:"not null;uniqueIndex:idx_submission_reviewer" json:"reviewer_id"`
+	// 投票: approve, reject
+	Vote      string    `gorm:"type:varchar(20);not null" json:"vote"`
+	Notes     string    `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ReviewComment 审核行内评论表（类似 PR review comment）
+// 根评论锚定在提交 proposed 版本的行范围上，回复通过 ParentID 挂在根评论下（只有一层）
+// 提交推送新修订时根评论的锚点随内容重新定位，锚定的行全部被删除时标记为过时
+type ReviewComment struct {
+	ID           uint `gorm:"primaryKey" json:"id"`
+	SubmissionID uint `gorm:"not null;index" json:"submission_id"`
+	// 所属根评论ID（为空表示根评论）
+	ParentID *uint `gorm:"index" json:"parent_id,omitempty"`
+	// 当前锚定的版本及行范围（从1开始，闭区间）
+	VersionID uint `gorm:"not null" json:"version_id"`
+	StartLine int  `gorm:"not null" json:"start_line"`
+	EndLine   int  `gorm:"not null" json:"end_line"`
+	// 评论创建时锚定的版本及行范围
+	OriginalVersionID uint `gorm:"not null" json:"original_version_id"`
+	OriginalStartLine int  `gorm:"not null" json:"original_start_line"`
+	OriginalEndLine   int  `gorm:"not null" json:"original_end_line"`
+	// 锚定的行在新修订中已不存在
+	IsOutdated bool      `gorm:"default:false" json:"is_outdated"`
+	AuthorID   uint      `gorm:"not null;index" json:"author_id"`
+	Content    string    `gorm:"type:text;not null" json:"content"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 // VersionConflict 版本冲突记录表 (用于追踪和解决冲突)
@@ -0,0 +1,19 @@
+package article
+
+import "time"
+
+// ArticleDraft 个人草稿表（每个用户在每篇文章下最多一份草稿）
+// 草稿只属于编辑者本人，不会生成 ArticleVersion，也不出现在文章历史中
+type ArticleDraft struct {
+	ID        uint `gorm:"primaryKey" json:"id"`
+	ArticleID uint `gorm:"not null;uniqueIndex:idx_draft_article_user" json:"article_id"`
+	UserID    uint `gorm:"not null;uniqueIndex:idx_draft_article_user;index" json:"user_id"`
+	// 草稿开始编辑时所基于的版本ID（提交时作为3路合并的base）
+	BaseVersionID uint `gorm:"not null" json:"base_version_id"`
+	// 草稿内容
+	Content string `gorm:"type:text;not null" json:"content"`
+	// 预填的提交信息
+	CommitMessage string    `gorm:"type:varchar(255)" json:"commit_message"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
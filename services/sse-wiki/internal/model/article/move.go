@@ -0,0 +1,15 @@
+package article
+
+import "time"
+
+// ArticleMove 文章移动记录表
+// 记录文章在模块间的移动，用于文章历史展示以及旧模块路径（面包屑）的解析
+type ArticleMove struct {
+	ID        uint `gorm:"primaryKey" json:"id"`
+	ArticleID uint `gorm:"not null;index" json:"article_id"`
+	// 移动前后的模块ID
+	FromModuleID uint      `gorm:"not null;index" json:"from_module_id"`
+	ToModuleID   uint      `gorm:"not null" json:"to_module_id"`
+	MovedBy      uint      `gorm:"not null" json:"moved_by"`
+	CreatedAt    time.Time `json:"created_at"`
+}
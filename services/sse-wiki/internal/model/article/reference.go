@@ -9,5 +9,7 @@ type ArticleReference struct {
 	ToArticleID   uint      `gorm:"primaryKey;index" json:"to_article_id"`   // 被引用
 	// 引用类型：prerequisite(前置知识), related(相关文章), extends(扩展阅读)
 	ReferenceType string    `gorm:"type:varchar(50);not null" json:"reference_type"`
+	// 是否由正文中的内部链接自动生成（发布时同步，链接删除后自动移除）
+	IsAuto        bool      `gorm:"not null;default:false" json:"is_auto"`
 	CreatedAt     time.Time `json:"created_at"`
 }
@@ -0,0 +1,21 @@
+package article
+
+import "time"
+
+// ArticleSearchIndex 文章全文搜索索引表
+// 每篇文章一行，只保存当前版本预先分词后的 tsvector（中文按单字+二元组切分，使用 simple 配置），
+// 不重复保存正文，搜索摘要在查询时从版本内容生成
+// 文章 CurrentVersionID 或标题变化时增量更新
+type ArticleSearchIndex struct {
+	ArticleID uint `gorm:"primaryKey;autoIncrement:false" json:"article_id"`
+	// 建立索引时的文章版本，与 articles.current_version_id 不一致表示索引已过期
+	VersionID    uint      `gorm:"not null" json:"version_id"`
+	Title        string    `gorm:"type:varchar(255);not null" json:"title"`
+	SearchVector string    `gorm:"type:tsvector;index:idx_article_search_vector,type:gin" json:"-"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ArticleSearchIndex) TableName() string {
+	return "article_search_index"
+}
@@ -0,0 +1,31 @@
+package article
+
+import "time"
+
+// ArticleDailyStat 文章每日阅读统计
+// 由阅读量写入任务从 Redis 汇总写入：Views 为当天阅读次数，UniqueVisitors 为当天独立访客数（HyperLogLog 估算）
+type ArticleDailyStat struct {
+	ArticleID uint `gorm:"primaryKey;autoIncrement:false" json:"article_id"`
+	// 统计日期（服务器本地日期）
+	Date           time.Time `gorm:"primaryKey;type:date" json:"date"`
+	Views          int64     `gorm:"not null;default:0" json:"views"`
+	UniqueVisitors int64     `gorm:"not null;default:0" json:"unique_visitors"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ArticleDailyStat) TableName() string {
+	return "article_daily_stats"
+}
+
+// ViewFlushBatch 已写入 view_count 的阅读量批次
+// 与阅读量更新在同一事务中写入；事务提交后 Redis 中的批次未能删除时，下一轮据此跳过，避免重复累加
+type ViewFlushBatch struct {
+	BatchID   string    `gorm:"primaryKey;type:varchar(64)" json:"batch_id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+}
+
+// TableName 指定表名
+func (ViewFlushBatch) TableName() string {
+	return "view_flush_batches"
+}
@@ -24,7 +24,15 @@ func InitTable(db *gorm.DB) error {
 		&article.Article{},
 		&article.ArticleCollaborator{},
 		&article.ArticleVersion{},
+		&article.ArticleDraft{},
+		&article.ArticleMove{},
+		&article.ArticleSearchIndex{},
+		&article.ArticleDailyStat{},
+		&article.ViewFlushBatch{},
 		&article.ReviewSubmission{},
+		&article.SubmissionRevision{},
+		&article.ReviewVote{},
+		&article.ReviewComment{},
 		&article.VersionConflict{},
 		&article.ArticleReference{},
 		&article.Tag{},
@@ -9,6 +9,8 @@ type Module struct {
 	Description *string   `gorm:"type:text;default:null" json:"description"`
 	ParentID   *uint     `gorm:"index;default:null" json:"parent_id"` // NULL表示顶级模块
 	OwnerID    uint      `gorm:"not null;index" json:"owner_id"`
+	// 模块内文章审核通过所需的审核人数（为空表示继承父模块，顶级模块默认为1）
+	RequiredApprovals *int `gorm:"default:null" json:"required_approvals"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 
@@ -315,6 +315,14 @@ func (s *ModuleService) UpdateModule(id uint, req UpdateModuleRequest, userID ui
 	if shouldUpdateParentID {
 		module.ParentID = req.ParentID
 	}
+	// 审核通过所需人数，0 表示继承父模块
+	if req.RequiredApprovals != nil {
+		if *req.RequiredApprovals > 0 {
+			module.RequiredApprovals = req.RequiredApprovals
+		} else {
+			module.RequiredApprovals = nil
+		}
+	}
 
 	if err := s.moduleRepo.UpdateModule(module); err != nil {
 		return response.NewBusinessError(
@@ -178,7 +178,7 @@ func TestCreateModule_Integration(t *testing.T) {
 			userRole: "admin",
 			req: CreateModuleRequest{
 				Name:        "Root Module",
-				Description: "Root module description",
+				Description: stringPtr("Root module description"),
 				ParentID:    nil,
 			},
 			expectError: false,
@@ -190,7 +190,7 @@ func TestCreateModule_Integration(t *testing.T) {
 			userRole: "user",
 			req: CreateModuleRequest{
 				Name:        "Child Module",
-				Description: "Child module description",
+				Description: stringPtr("Child module description"),
 				ParentID:    &parentModule.ID,
 			},
 			expectError: false,
@@ -202,7 +202,7 @@ func TestCreateModule_Integration(t *testing.T) {
 			userRole: "user",
 			req: CreateModuleRequest{
 				Name:        "Admin Child Module",
-				Description: "Admin child module description",
+				Description: stringPtr("Admin child module description"),
 				ParentID:    &parentModule.ID,
 			},
 			expectError: false,
@@ -214,7 +214,7 @@ func TestCreateModule_Integration(t *testing.T) {
 			userRole: "user",
 			req: CreateModuleRequest{
 				Name:        "Moderator Child Module",
-				Description: "Moderator child module description",
+				Description: stringPtr("Moderator child module description"),
 				ParentID:    &parentModule.ID,
 			},
 			expectError: false,
@@ -226,7 +226,7 @@ func TestCreateModule_Integration(t *testing.T) {
 			userRole: "user",
 			req: CreateModuleRequest{
 				Name:        "Root Module",
-				Description: "Root module description",
+				Description: stringPtr("Root module description"),
 				ParentID:    nil,
 			},
 			expectError: true,
@@ -239,7 +239,7 @@ func TestCreateModule_Integration(t *testing.T) {
 			userRole: "user",
 			req: CreateModuleRequest{
 				Name:        "Child Module",
-				Description: "Child module description",
+				Description: stringPtr("Child module description"),
 				ParentID:    &parentModule.ID,
 			},
 			expectError: true,
@@ -1165,3 +1165,8 @@ func TestLockService_Integration(t *testing.T) {
 	})
 }
 
+
+// stringPtr 返回字符串指针
+func stringPtr(s string) *string {
+	return &s
+}
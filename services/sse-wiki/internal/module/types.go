@@ -23,6 +23,8 @@ type UpdateModuleRequest struct {
 	Name        string  `json:"name" binding:"required,min=1,max=100"`
 	Description *string `json:"description" binding:"omitempty,max=512"`
 	ParentID    *uint   `json:"parent_id"`
+	// 模块内文章审核通过所需人数，0 表示继承父模块，不传表示不修改
+	RequiredApprovals *int `json:"required_approvals" binding:"omitempty,min=0"`
 }
 
 // BreadcrumbNode 面包屑节点
@@ -64,10 +64,11 @@ func WithRole(role string) UserOption {
 func CreateTestModule(db *gorm.DB, ownerID uint, opts ...ModuleOption) *module.Module {
 	uniqueID := uuid.New().String()
 	moduleName := fmt.Sprintf("test_module_%s", uniqueID)
-	
+	description := "Test module description"
+
 	testModule := &module.Module{
 		ModuleName: moduleName,
-		Description: "Test module description",
+		Description: &description,
 		OwnerID:    ownerID,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
@@ -20,19 +20,46 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ArticleService_GetArticlesByModule_FullMethodName      = "/article_service.ArticleService/GetArticlesByModule"
+	ArticleService_GetArticlesByTags_FullMethodName        = "/article_service.ArticleService/GetArticlesByTags"
+	ArticleService_SearchArticles_FullMethodName           = "/article_service.ArticleService/SearchArticles"
+	ArticleService_GetTrendingArticles_FullMethodName      = "/article_service.ArticleService/GetTrendingArticles"
 	ArticleService_GetArticle_FullMethodName               = "/article_service.ArticleService/GetArticle"
 	ArticleService_GetVersions_FullMethodName              = "/article_service.ArticleService/GetVersions"
 	ArticleService_GetVersion_FullMethodName               = "/article_service.ArticleService/GetVersion"
 	ArticleService_GetVersionDiff_FullMethodName           = "/article_service.ArticleService/GetVersionDiff"
+	ArticleService_CompareVersions_FullMethodName          = "/article_service.ArticleService/CompareVersions"
+	ArticleService_GetArticleBlame_FullMethodName          = "/article_service.ArticleService/GetArticleBlame"
 	ArticleService_GetUserArticleFavourites_FullMethodName = "/article_service.ArticleService/GetUserArticleFavourites"
 	ArticleService_UpdateUserFavourites_FullMethodName     = "/article_service.ArticleService/UpdateUserFavourites"
 	ArticleService_CreateArticle_FullMethodName            = "/article_service.ArticleService/CreateArticle"
 	ArticleService_CreateSubmission_FullMethodName         = "/article_service.ArticleService/CreateSubmission"
+	ArticleService_UpdateSubmission_FullMethodName         = "/article_service.ArticleService/UpdateSubmission"
+	ArticleService_ReviseSubmission_FullMethodName         = "/article_service.ArticleService/ReviseSubmission"
+	ArticleService_WithdrawSubmission_FullMethodName       = "/article_service.ArticleService/WithdrawSubmission"
+	ArticleService_RevertToVersion_FullMethodName          = "/article_service.ArticleService/RevertToVersion"
 	ArticleService_UpdateBasicInfo_FullMethodName          = "/article_service.ArticleService/UpdateBasicInfo"
+	ArticleService_SaveDraft_FullMethodName                = "/article_service.ArticleService/SaveDraft"
+	ArticleService_GetDraft_FullMethodName                 = "/article_service.ArticleService/GetDraft"
+	ArticleService_DiscardDraft_FullMethodName             = "/article_service.ArticleService/DiscardDraft"
+	ArticleService_ListMyDrafts_FullMethodName             = "/article_service.ArticleService/ListMyDrafts"
+	ArticleService_SubmitDraft_FullMethodName              = "/article_service.ArticleService/SubmitDraft"
 	ArticleService_GetCollaborators_FullMethodName         = "/article_service.ArticleService/GetCollaborators"
 	ArticleService_AddCollaborator_FullMethodName          = "/article_service.ArticleService/AddCollaborator"
 	ArticleService_RemoveCollaborator_FullMethodName       = "/article_service.ArticleService/RemoveCollaborator"
+	ArticleService_MoveArticle_FullMethodName              = "/article_service.ArticleService/MoveArticle"
+	ArticleService_ResolveArticlePath_FullMethodName       = "/article_service.ArticleService/ResolveArticlePath"
 	ArticleService_DeleteArticle_FullMethodName            = "/article_service.ArticleService/DeleteArticle"
+	ArticleService_ListTrash_FullMethodName                = "/article_service.ArticleService/ListTrash"
+	ArticleService_RestoreArticle_FullMethodName           = "/article_service.ArticleService/RestoreArticle"
+	ArticleService_PurgeArticle_FullMethodName             = "/article_service.ArticleService/PurgeArticle"
+	ArticleService_AddReference_FullMethodName             = "/article_service.ArticleService/AddReference"
+	ArticleService_RemoveReference_FullMethodName          = "/article_service.ArticleService/RemoveReference"
+	ArticleService_GetArticleReferences_FullMethodName     = "/article_service.ArticleService/GetArticleReferences"
+	ArticleService_GetLearningPath_FullMethodName          = "/article_service.ArticleService/GetLearningPath"
+	ArticleService_GetBrokenLinkReport_FullMethodName      = "/article_service.ArticleService/GetBrokenLinkReport"
+	ArticleService_GetArticleStats_FullMethodName          = "/article_service.ArticleService/GetArticleStats"
+	ArticleService_GetActivityFeed_FullMethodName          = "/article_service.ArticleService/GetActivityFeed"
+	ArticleService_GetFeed_FullMethodName                  = "/article_service.ArticleService/GetFeed"
 )
 
 // ArticleServiceClient is the client API for ArticleService service.
@@ -41,22 +68,57 @@ const (
 type ArticleServiceClient interface {
 	// 浏览功能
 	GetArticlesByModule(ctx context.Context, in *GetArticlesByModuleRequest, opts ...grpc.CallOption) (*GetArticlesByModuleResponse, error)
+	GetArticlesByTags(ctx context.Context, in *GetArticlesByTagsRequest, opts ...grpc.CallOption) (*GetArticlesByTagsResponse, error)
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error)
+	GetTrendingArticles(ctx context.Context, in *GetTrendingArticlesRequest, opts ...grpc.CallOption) (*GetTrendingArticlesResponse, error)
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error)
 	GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*GetVersionsResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	GetVersionDiff(ctx context.Context, in *GetVersionDiffRequest, opts ...grpc.CallOption) (*GetVersionDiffResponse, error)
+	CompareVersions(ctx context.Context, in *CompareVersionsRequest, opts ...grpc.CallOption) (*CompareVersionsResponse, error)
+	GetArticleBlame(ctx context.Context, in *GetArticleBlameRequest, opts ...grpc.CallOption) (*GetArticleBlameResponse, error)
 	GetUserArticleFavourites(ctx context.Context, in *GetArticleFavouritesRequest, opts ...grpc.CallOption) (*GetArticleFavouritesResponse, error)
 	// 编辑功能
 	UpdateUserFavourites(ctx context.Context, in *UpdateUserFavouritesRequest, opts ...grpc.CallOption) (*UpdateUserFavouritesResponse, error)
 	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*CreateArticleResponse, error)
 	CreateSubmission(ctx context.Context, in *CreateSubmissionRequest, opts ...grpc.CallOption) (*CreateSubmissionResponse, error)
+	UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*UpdateSubmissionResponse, error)
+	ReviseSubmission(ctx context.Context, in *ReviseSubmissionRequest, opts ...grpc.CallOption) (*ReviseSubmissionResponse, error)
+	WithdrawSubmission(ctx context.Context, in *WithdrawSubmissionRequest, opts ...grpc.CallOption) (*WithdrawSubmissionResponse, error)
+	RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...grpc.CallOption) (*RevertToVersionResponse, error)
 	UpdateBasicInfo(ctx context.Context, in *UpdateBasicInfoRequest, opts ...grpc.CallOption) (*UpdateBasicInfoResponse, error)
+	// 个人草稿
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error)
+	GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*GetDraftResponse, error)
+	DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*DiscardDraftResponse, error)
+	ListMyDrafts(ctx context.Context, in *ListMyDraftsRequest, opts ...grpc.CallOption) (*ListMyDraftsResponse, error)
+	SubmitDraft(ctx context.Context, in *SubmitDraftRequest, opts ...grpc.CallOption) (*SubmitDraftResponse, error)
 	// 协作者管理
 	GetCollaborators(ctx context.Context, in *GetCollaboratorsRequest, opts ...grpc.CallOption) (*GetCollaboratorsResponse, error)
 	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error)
 	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
+	// 移动文章
+	MoveArticle(ctx context.Context, in *MoveArticleRequest, opts ...grpc.CallOption) (*MoveArticleResponse, error)
+	ResolveArticlePath(ctx context.Context, in *ResolveArticlePathRequest, opts ...grpc.CallOption) (*ResolveArticlePathResponse, error)
 	// 删除文章
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*DeleteArticleResponse, error)
+	// 回收站
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleResponse, error)
+	PurgeArticle(ctx context.Context, in *PurgeArticleRequest, opts ...grpc.CallOption) (*PurgeArticleResponse, error)
+	// 文章引用
+	AddReference(ctx context.Context, in *AddReferenceRequest, opts ...grpc.CallOption) (*AddReferenceResponse, error)
+	RemoveReference(ctx context.Context, in *RemoveReferenceRequest, opts ...grpc.CallOption) (*RemoveReferenceResponse, error)
+	GetArticleReferences(ctx context.Context, in *GetArticleReferencesRequest, opts ...grpc.CallOption) (*GetArticleReferencesResponse, error)
+	GetLearningPath(ctx context.Context, in *GetLearningPathRequest, opts ...grpc.CallOption) (*GetLearningPathResponse, error)
+	// 失效链接报告
+	GetBrokenLinkReport(ctx context.Context, in *GetBrokenLinkReportRequest, opts ...grpc.CallOption) (*GetBrokenLinkReportResponse, error)
+	// 文章统计
+	GetArticleStats(ctx context.Context, in *GetArticleStatsRequest, opts ...grpc.CallOption) (*GetArticleStatsResponse, error)
+	// 全站动态
+	GetActivityFeed(ctx context.Context, in *GetActivityFeedRequest, opts ...grpc.CallOption) (*GetActivityFeedResponse, error)
+	// 订阅源
+	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
 }
 
 type articleServiceClient struct {
@@ -77,6 +139,36 @@ func (c *articleServiceClient) GetArticlesByModule(ctx context.Context, in *GetA
 	return out, nil
 }
 
+func (c *articleServiceClient) GetArticlesByTags(ctx context.Context, in *GetArticlesByTagsRequest, opts ...grpc.CallOption) (*GetArticlesByTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticlesByTagsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticlesByTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SearchArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetTrendingArticles(ctx context.Context, in *GetTrendingArticlesRequest, opts ...grpc.CallOption) (*GetTrendingArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetTrendingArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetArticleResponse)
@@ -117,6 +209,26 @@ func (c *articleServiceClient) GetVersionDiff(ctx context.Context, in *GetVersio
 	return out, nil
 }
 
+func (c *articleServiceClient) CompareVersions(ctx context.Context, in *CompareVersionsRequest, opts ...grpc.CallOption) (*CompareVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareVersionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CompareVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticleBlame(ctx context.Context, in *GetArticleBlameRequest, opts ...grpc.CallOption) (*GetArticleBlameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleBlameResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticleBlame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) GetUserArticleFavourites(ctx context.Context, in *GetArticleFavouritesRequest, opts ...grpc.CallOption) (*GetArticleFavouritesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetArticleFavouritesResponse)
@@ -157,6 +269,46 @@ func (c *articleServiceClient) CreateSubmission(ctx context.Context, in *CreateS
 	return out, nil
 }
 
+func (c *articleServiceClient) UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*UpdateSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSubmissionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_UpdateSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ReviseSubmission(ctx context.Context, in *ReviseSubmissionRequest, opts ...grpc.CallOption) (*ReviseSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviseSubmissionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ReviseSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) WithdrawSubmission(ctx context.Context, in *WithdrawSubmissionRequest, opts ...grpc.CallOption) (*WithdrawSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawSubmissionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_WithdrawSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...grpc.CallOption) (*RevertToVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertToVersionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RevertToVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) UpdateBasicInfo(ctx context.Context, in *UpdateBasicInfoRequest, opts ...grpc.CallOption) (*UpdateBasicInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateBasicInfoResponse)
@@ -167,6 +319,56 @@ func (c *articleServiceClient) UpdateBasicInfo(ctx context.Context, in *UpdateBa
 	return out, nil
 }
 
+func (c *articleServiceClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveDraftResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*GetDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDraftResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*DiscardDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardDraftResponse)
+	err := c.cc.Invoke(ctx, ArticleService_DiscardDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListMyDrafts(ctx context.Context, in *ListMyDraftsRequest, opts ...grpc.CallOption) (*ListMyDraftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyDraftsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListMyDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SubmitDraft(ctx context.Context, in *SubmitDraftRequest, opts ...grpc.CallOption) (*SubmitDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitDraftResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SubmitDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) GetCollaborators(ctx context.Context, in *GetCollaboratorsRequest, opts ...grpc.CallOption) (*GetCollaboratorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCollaboratorsResponse)
@@ -197,6 +399,26 @@ func (c *articleServiceClient) RemoveCollaborator(ctx context.Context, in *Remov
 	return out, nil
 }
 
+func (c *articleServiceClient) MoveArticle(ctx context.Context, in *MoveArticleRequest, opts ...grpc.CallOption) (*MoveArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_MoveArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ResolveArticlePath(ctx context.Context, in *ResolveArticlePathRequest, opts ...grpc.CallOption) (*ResolveArticlePathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveArticlePathResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ResolveArticlePath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*DeleteArticleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteArticleResponse)
@@ -207,28 +429,173 @@ func (c *articleServiceClient) DeleteArticle(ctx context.Context, in *DeleteArti
 	return out, nil
 }
 
+func (c *articleServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RestoreArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) PurgeArticle(ctx context.Context, in *PurgeArticleRequest, opts ...grpc.CallOption) (*PurgeArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_PurgeArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) AddReference(ctx context.Context, in *AddReferenceRequest, opts ...grpc.CallOption) (*AddReferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReferenceResponse)
+	err := c.cc.Invoke(ctx, ArticleService_AddReference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RemoveReference(ctx context.Context, in *RemoveReferenceRequest, opts ...grpc.CallOption) (*RemoveReferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReferenceResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RemoveReference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticleReferences(ctx context.Context, in *GetArticleReferencesRequest, opts ...grpc.CallOption) (*GetArticleReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleReferencesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticleReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetLearningPath(ctx context.Context, in *GetLearningPathRequest, opts ...grpc.CallOption) (*GetLearningPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLearningPathResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetBrokenLinkReport(ctx context.Context, in *GetBrokenLinkReportRequest, opts ...grpc.CallOption) (*GetBrokenLinkReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBrokenLinkReportResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetBrokenLinkReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticleStats(ctx context.Context, in *GetArticleStatsRequest, opts ...grpc.CallOption) (*GetArticleStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleStatsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticleStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetActivityFeed(ctx context.Context, in *GetActivityFeedRequest, opts ...grpc.CallOption) (*GetActivityFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityFeedResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetActivityFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticleServiceServer is the server API for ArticleService service.
 // All implementations must embed UnimplementedArticleServiceServer
 // for forward compatibility.
 type ArticleServiceServer interface {
 	// 浏览功能
 	GetArticlesByModule(context.Context, *GetArticlesByModuleRequest) (*GetArticlesByModuleResponse, error)
+	GetArticlesByTags(context.Context, *GetArticlesByTagsRequest) (*GetArticlesByTagsResponse, error)
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error)
+	GetTrendingArticles(context.Context, *GetTrendingArticlesRequest) (*GetTrendingArticlesResponse, error)
 	GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error)
 	GetVersions(context.Context, *GetVersionsRequest) (*GetVersionsResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	GetVersionDiff(context.Context, *GetVersionDiffRequest) (*GetVersionDiffResponse, error)
+	CompareVersions(context.Context, *CompareVersionsRequest) (*CompareVersionsResponse, error)
+	GetArticleBlame(context.Context, *GetArticleBlameRequest) (*GetArticleBlameResponse, error)
 	GetUserArticleFavourites(context.Context, *GetArticleFavouritesRequest) (*GetArticleFavouritesResponse, error)
 	// 编辑功能
 	UpdateUserFavourites(context.Context, *UpdateUserFavouritesRequest) (*UpdateUserFavouritesResponse, error)
 	CreateArticle(context.Context, *CreateArticleRequest) (*CreateArticleResponse, error)
 	CreateSubmission(context.Context, *CreateSubmissionRequest) (*CreateSubmissionResponse, error)
+	UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*UpdateSubmissionResponse, error)
+	ReviseSubmission(context.Context, *ReviseSubmissionRequest) (*ReviseSubmissionResponse, error)
+	WithdrawSubmission(context.Context, *WithdrawSubmissionRequest) (*WithdrawSubmissionResponse, error)
+	RevertToVersion(context.Context, *RevertToVersionRequest) (*RevertToVersionResponse, error)
 	UpdateBasicInfo(context.Context, *UpdateBasicInfoRequest) (*UpdateBasicInfoResponse, error)
+	// 个人草稿
+	SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error)
+	GetDraft(context.Context, *GetDraftRequest) (*GetDraftResponse, error)
+	DiscardDraft(context.Context, *DiscardDraftRequest) (*DiscardDraftResponse, error)
+	ListMyDrafts(context.Context, *ListMyDraftsRequest) (*ListMyDraftsResponse, error)
+	SubmitDraft(context.Context, *SubmitDraftRequest) (*SubmitDraftResponse, error)
 	// 协作者管理
 	GetCollaborators(context.Context, *GetCollaboratorsRequest) (*GetCollaboratorsResponse, error)
 	AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error)
 	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
+	// 移动文章
+	MoveArticle(context.Context, *MoveArticleRequest) (*MoveArticleResponse, error)
+	ResolveArticlePath(context.Context, *ResolveArticlePathRequest) (*ResolveArticlePathResponse, error)
 	// 删除文章
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error)
+	// 回收站
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleResponse, error)
+	PurgeArticle(context.Context, *PurgeArticleRequest) (*PurgeArticleResponse, error)
+	// 文章引用
+	AddReference(context.Context, *AddReferenceRequest) (*AddReferenceResponse, error)
+	RemoveReference(context.Context, *RemoveReferenceRequest) (*RemoveReferenceResponse, error)
+	GetArticleReferences(context.Context, *GetArticleReferencesRequest) (*GetArticleReferencesResponse, error)
+	GetLearningPath(context.Context, *GetLearningPathRequest) (*GetLearningPathResponse, error)
+	// 失效链接报告
+	GetBrokenLinkReport(context.Context, *GetBrokenLinkReportRequest) (*GetBrokenLinkReportResponse, error)
+	// 文章统计
+	GetArticleStats(context.Context, *GetArticleStatsRequest) (*GetArticleStatsResponse, error)
+	// 全站动态
+	GetActivityFeed(context.Context, *GetActivityFeedRequest) (*GetActivityFeedResponse, error)
+	// 订阅源
+	GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error)
 	mustEmbedUnimplementedArticleServiceServer()
 }
 
@@ -242,6 +609,15 @@ type UnimplementedArticleServiceServer struct{}
 func (UnimplementedArticleServiceServer) GetArticlesByModule(context.Context, *GetArticlesByModuleRequest) (*GetArticlesByModuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArticlesByModule not implemented")
 }
+func (UnimplementedArticleServiceServer) GetArticlesByTags(context.Context, *GetArticlesByTagsRequest) (*GetArticlesByTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticlesByTags not implemented")
+}
+func (UnimplementedArticleServiceServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) GetTrendingArticles(context.Context, *GetTrendingArticlesRequest) (*GetTrendingArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingArticles not implemented")
+}
 func (UnimplementedArticleServiceServer) GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
 }
@@ -254,6 +630,12 @@ func (UnimplementedArticleServiceServer) GetVersion(context.Context, *GetVersion
 func (UnimplementedArticleServiceServer) GetVersionDiff(context.Context, *GetVersionDiffRequest) (*GetVersionDiffResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersionDiff not implemented")
 }
+func (UnimplementedArticleServiceServer) CompareVersions(context.Context, *CompareVersionsRequest) (*CompareVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareVersions not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticleBlame(context.Context, *GetArticleBlameRequest) (*GetArticleBlameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleBlame not implemented")
+}
 func (UnimplementedArticleServiceServer) GetUserArticleFavourites(context.Context, *GetArticleFavouritesRequest) (*GetArticleFavouritesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserArticleFavourites not implemented")
 }
@@ -266,9 +648,36 @@ func (UnimplementedArticleServiceServer) CreateArticle(context.Context, *CreateA
 func (UnimplementedArticleServiceServer) CreateSubmission(context.Context, *CreateSubmissionRequest) (*CreateSubmissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubmission not implemented")
 }
+func (UnimplementedArticleServiceServer) UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*UpdateSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmission not implemented")
+}
+func (UnimplementedArticleServiceServer) ReviseSubmission(context.Context, *ReviseSubmissionRequest) (*ReviseSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviseSubmission not implemented")
+}
+func (UnimplementedArticleServiceServer) WithdrawSubmission(context.Context, *WithdrawSubmissionRequest) (*WithdrawSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSubmission not implemented")
+}
+func (UnimplementedArticleServiceServer) RevertToVersion(context.Context, *RevertToVersionRequest) (*RevertToVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToVersion not implemented")
+}
 func (UnimplementedArticleServiceServer) UpdateBasicInfo(context.Context, *UpdateBasicInfoRequest) (*UpdateBasicInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasicInfo not implemented")
 }
+func (UnimplementedArticleServiceServer) SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedArticleServiceServer) GetDraft(context.Context, *GetDraftRequest) (*GetDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraft not implemented")
+}
+func (UnimplementedArticleServiceServer) DiscardDraft(context.Context, *DiscardDraftRequest) (*DiscardDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardDraft not implemented")
+}
+func (UnimplementedArticleServiceServer) ListMyDrafts(context.Context, *ListMyDraftsRequest) (*ListMyDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyDrafts not implemented")
+}
+func (UnimplementedArticleServiceServer) SubmitDraft(context.Context, *SubmitDraftRequest) (*SubmitDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDraft not implemented")
+}
 func (UnimplementedArticleServiceServer) GetCollaborators(context.Context, *GetCollaboratorsRequest) (*GetCollaboratorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCollaborators not implemented")
 }
@@ -278,9 +687,48 @@ func (UnimplementedArticleServiceServer) AddCollaborator(context.Context, *AddCo
 func (UnimplementedArticleServiceServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
 }
+func (UnimplementedArticleServiceServer) MoveArticle(context.Context, *MoveArticleRequest) (*MoveArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ResolveArticlePath(context.Context, *ResolveArticlePathRequest) (*ResolveArticlePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveArticlePath not implemented")
+}
 func (UnimplementedArticleServiceServer) DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
 }
+func (UnimplementedArticleServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedArticleServiceServer) RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) PurgeArticle(context.Context, *PurgeArticleRequest) (*PurgeArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) AddReference(context.Context, *AddReferenceRequest) (*AddReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReference not implemented")
+}
+func (UnimplementedArticleServiceServer) RemoveReference(context.Context, *RemoveReferenceRequest) (*RemoveReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReference not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticleReferences(context.Context, *GetArticleReferencesRequest) (*GetArticleReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleReferences not implemented")
+}
+func (UnimplementedArticleServiceServer) GetLearningPath(context.Context, *GetLearningPathRequest) (*GetLearningPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningPath not implemented")
+}
+func (UnimplementedArticleServiceServer) GetBrokenLinkReport(context.Context, *GetBrokenLinkReportRequest) (*GetBrokenLinkReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBrokenLinkReport not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticleStats(context.Context, *GetArticleStatsRequest) (*GetArticleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleStats not implemented")
+}
+func (UnimplementedArticleServiceServer) GetActivityFeed(context.Context, *GetActivityFeedRequest) (*GetActivityFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityFeed not implemented")
+}
+func (UnimplementedArticleServiceServer) GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
 func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
 func (UnimplementedArticleServiceServer) testEmbeddedByValue()                        {}
 
@@ -320,6 +768,60 @@ func _ArticleService_GetArticlesByModule_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_GetArticlesByTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticlesByTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticlesByTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticlesByTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticlesByTags(ctx, req.(*GetArticlesByTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetTrendingArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetTrendingArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetTrendingArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetTrendingArticles(ctx, req.(*GetTrendingArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArticleService_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetArticleRequest)
 	if err := dec(in); err != nil {
@@ -392,7 +894,43 @@ func _ArticleService_GetVersionDiff_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_GetUserArticleFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ArticleService_CompareVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CompareVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CompareVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CompareVersions(ctx, req.(*CompareVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticleBlame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleBlameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticleBlame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticleBlame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticleBlame(ctx, req.(*GetArticleBlameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetUserArticleFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetArticleFavouritesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -464,6 +1002,78 @@ func _ArticleService_CreateSubmission_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_UpdateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UpdateSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UpdateSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UpdateSubmission(ctx, req.(*UpdateSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ReviseSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviseSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ReviseSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ReviseSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ReviseSubmission(ctx, req.(*ReviseSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_WithdrawSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).WithdrawSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_WithdrawSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).WithdrawSubmission(ctx, req.(*WithdrawSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RevertToVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertToVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RevertToVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RevertToVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RevertToVersion(ctx, req.(*RevertToVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArticleService_UpdateBasicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateBasicInfoRequest)
 	if err := dec(in); err != nil {
@@ -482,6 +1092,96 @@ func _ArticleService_UpdateBasicInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SaveDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SaveDraft(ctx, req.(*SaveDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetDraft(ctx, req.(*GetDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DiscardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DiscardDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DiscardDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DiscardDraft(ctx, req.(*DiscardDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListMyDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListMyDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListMyDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListMyDrafts(ctx, req.(*ListMyDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SubmitDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SubmitDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SubmitDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SubmitDraft(ctx, req.(*SubmitDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArticleService_GetCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCollaboratorsRequest)
 	if err := dec(in); err != nil {
@@ -536,6 +1236,42 @@ func _ArticleService_RemoveCollaborator_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_MoveArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).MoveArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_MoveArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).MoveArticle(ctx, req.(*MoveArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ResolveArticlePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveArticlePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ResolveArticlePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ResolveArticlePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ResolveArticlePath(ctx, req.(*ResolveArticlePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArticleService_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteArticleRequest)
 	if err := dec(in); err != nil {
@@ -554,6 +1290,204 @@ func _ArticleService_DeleteArticle_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RestoreArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, req.(*RestoreArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_PurgeArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).PurgeArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_PurgeArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).PurgeArticle(ctx, req.(*PurgeArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_AddReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).AddReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_AddReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).AddReference(ctx, req.(*AddReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RemoveReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RemoveReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RemoveReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RemoveReference(ctx, req.(*RemoveReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticleReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticleReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticleReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticleReferences(ctx, req.(*GetArticleReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetLearningPath(ctx, req.(*GetLearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetBrokenLinkReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBrokenLinkReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetBrokenLinkReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetBrokenLinkReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetBrokenLinkReport(ctx, req.(*GetBrokenLinkReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticleStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticleStats(ctx, req.(*GetArticleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetActivityFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetActivityFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetActivityFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetActivityFeed(ctx, req.(*GetActivityFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetFeed(ctx, req.(*GetFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArticleService_ServiceDesc is the grpc.ServiceDesc for ArticleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -565,6 +1499,18 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetArticlesByModule",
 			Handler:    _ArticleService_GetArticlesByModule_Handler,
 		},
+		{
+			MethodName: "GetArticlesByTags",
+			Handler:    _ArticleService_GetArticlesByTags_Handler,
+		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _ArticleService_SearchArticles_Handler,
+		},
+		{
+			MethodName: "GetTrendingArticles",
+			Handler:    _ArticleService_GetTrendingArticles_Handler,
+		},
 		{
 			MethodName: "GetArticle",
 			Handler:    _ArticleService_GetArticle_Handler,
@@ -581,6 +1527,14 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersionDiff",
 			Handler:    _ArticleService_GetVersionDiff_Handler,
 		},
+		{
+			MethodName: "CompareVersions",
+			Handler:    _ArticleService_CompareVersions_Handler,
+		},
+		{
+			MethodName: "GetArticleBlame",
+			Handler:    _ArticleService_GetArticleBlame_Handler,
+		},
 		{
 			MethodName: "GetUserArticleFavourites",
 			Handler:    _ArticleService_GetUserArticleFavourites_Handler,
@@ -597,10 +1551,46 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSubmission",
 			Handler:    _ArticleService_CreateSubmission_Handler,
 		},
+		{
+			MethodName: "UpdateSubmission",
+			Handler:    _ArticleService_UpdateSubmission_Handler,
+		},
+		{
+			MethodName: "ReviseSubmission",
+			Handler:    _ArticleService_ReviseSubmission_Handler,
+		},
+		{
+			MethodName: "WithdrawSubmission",
+			Handler:    _ArticleService_WithdrawSubmission_Handler,
+		},
+		{
+			MethodName: "RevertToVersion",
+			Handler:    _ArticleService_RevertToVersion_Handler,
+		},
 		{
 			MethodName: "UpdateBasicInfo",
 			Handler:    _ArticleService_UpdateBasicInfo_Handler,
 		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _ArticleService_SaveDraft_Handler,
+		},
+		{
+			MethodName: "GetDraft",
+			Handler:    _ArticleService_GetDraft_Handler,
+		},
+		{
+			MethodName: "DiscardDraft",
+			Handler:    _ArticleService_DiscardDraft_Handler,
+		},
+		{
+			MethodName: "ListMyDrafts",
+			Handler:    _ArticleService_ListMyDrafts_Handler,
+		},
+		{
+			MethodName: "SubmitDraft",
+			Handler:    _ArticleService_SubmitDraft_Handler,
+		},
 		{
 			MethodName: "GetCollaborators",
 			Handler:    _ArticleService_GetCollaborators_Handler,
@@ -613,10 +1603,62 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCollaborator",
 			Handler:    _ArticleService_RemoveCollaborator_Handler,
 		},
+		{
+			MethodName: "MoveArticle",
+			Handler:    _ArticleService_MoveArticle_Handler,
+		},
+		{
+			MethodName: "ResolveArticlePath",
+			Handler:    _ArticleService_ResolveArticlePath_Handler,
+		},
 		{
 			MethodName: "DeleteArticle",
 			Handler:    _ArticleService_DeleteArticle_Handler,
 		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _ArticleService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _ArticleService_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "PurgeArticle",
+			Handler:    _ArticleService_PurgeArticle_Handler,
+		},
+		{
+			MethodName: "AddReference",
+			Handler:    _ArticleService_AddReference_Handler,
+		},
+		{
+			MethodName: "RemoveReference",
+			Handler:    _ArticleService_RemoveReference_Handler,
+		},
+		{
+			MethodName: "GetArticleReferences",
+			Handler:    _ArticleService_GetArticleReferences_Handler,
+		},
+		{
+			MethodName: "GetLearningPath",
+			Handler:    _ArticleService_GetLearningPath_Handler,
+		},
+		{
+			MethodName: "GetBrokenLinkReport",
+			Handler:    _ArticleService_GetBrokenLinkReport_Handler,
+		},
+		{
+			MethodName: "GetArticleStats",
+			Handler:    _ArticleService_GetArticleStats_Handler,
+		},
+		{
+			MethodName: "GetActivityFeed",
+			Handler:    _ArticleService_GetActivityFeed_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _ArticleService_GetFeed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/article_service/article_service.proto",
@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReviewService_GetReviews_FullMethodName      = "/review_service.ReviewService/GetReviews"
-	ReviewService_GetReviewDetail_FullMethodName = "/review_service.ReviewService/GetReviewDetail"
-	ReviewService_ReviewAction_FullMethodName    = "/review_service.ReviewService/ReviewAction"
+	ReviewService_GetReviews_FullMethodName       = "/review_service.ReviewService/GetReviews"
+	ReviewService_GetReviewDetail_FullMethodName  = "/review_service.ReviewService/GetReviewDetail"
+	ReviewService_ReviewAction_FullMethodName     = "/review_service.ReviewService/ReviewAction"
+	ReviewService_AddReviewComment_FullMethodName = "/review_service.ReviewService/AddReviewComment"
 )
 
 // ReviewServiceClient is the client API for ReviewService service.
@@ -31,6 +32,7 @@ type ReviewServiceClient interface {
 	GetReviews(ctx context.Context, in *GetReviewsRequest, opts ...grpc.CallOption) (*GetReviewsResponse, error)
 	GetReviewDetail(ctx context.Context, in *GetReviewDetailRequest, opts ...grpc.CallOption) (*GetReviewDetailResponse, error)
 	ReviewAction(ctx context.Context, in *ReviewActionRequest, opts ...grpc.CallOption) (*ReviewActionResponse, error)
+	AddReviewComment(ctx context.Context, in *AddReviewCommentRequest, opts ...grpc.CallOption) (*AddReviewCommentResponse, error)
 }
 
 type reviewServiceClient struct {
@@ -71,6 +73,16 @@ func (c *reviewServiceClient) ReviewAction(ctx context.Context, in *ReviewAction
 	return out, nil
 }
 
+func (c *reviewServiceClient) AddReviewComment(ctx context.Context, in *AddReviewCommentRequest, opts ...grpc.CallOption) (*AddReviewCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReviewCommentResponse)
+	err := c.cc.Invoke(ctx, ReviewService_AddReviewComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServiceServer is the server API for ReviewService service.
 // All implementations must embed UnimplementedReviewServiceServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type ReviewServiceServer interface {
 	GetReviews(context.Context, *GetReviewsRequest) (*GetReviewsResponse, error)
 	GetReviewDetail(context.Context, *GetReviewDetailRequest) (*GetReviewDetailResponse, error)
 	ReviewAction(context.Context, *ReviewActionRequest) (*ReviewActionResponse, error)
+	AddReviewComment(context.Context, *AddReviewCommentRequest) (*AddReviewCommentResponse, error)
 	mustEmbedUnimplementedReviewServiceServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedReviewServiceServer) GetReviewDetail(context.Context, *GetRev
 func (UnimplementedReviewServiceServer) ReviewAction(context.Context, *ReviewActionRequest) (*ReviewActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReviewAction not implemented")
 }
+func (UnimplementedReviewServiceServer) AddReviewComment(context.Context, *AddReviewCommentRequest) (*AddReviewCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReviewComment not implemented")
+}
 func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
 func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
 
@@ -172,6 +188,24 @@ func _ReviewService_ReviewAction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReviewService_AddReviewComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReviewCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).AddReviewComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_AddReviewComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).AddReviewComment(ctx, req.(*AddReviewCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReviewService_ServiceDesc is the grpc.ServiceDesc for ReviewService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReviewAction",
 			Handler:    _ReviewService_ReviewAction_Handler,
 		},
+		{
+			MethodName: "AddReviewComment",
+			Handler:    _ReviewService_AddReviewComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/review_service/review_service.proto",